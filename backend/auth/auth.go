@@ -0,0 +1,254 @@
+// Package auth links browser sessions to the game accounts of players.
+//
+// Players log in by beginning a Challenge, which gives them a one-time code
+// to enter in-game. Once a Verifier reports that they entered it, the
+// Challenge is exchanged for a Session, whose signed token authenticates
+// their requests.
+package auth
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// A Verifier checks that players control their game accounts, by having them
+// enter codes in-game.
+//
+// Codes that a player enters are kept until they are forgotten, since the
+// player may have several pending logins; a Service only calls Forget once a
+// code was used, and the player has no other pending logins. A Service never
+// calls Prompt and Forget concurrently for the same player.
+type Verifier interface {
+	// Prompt allows the player with the given username to enter code, and
+	// returns instructions for doing so. It does not forget codes that the
+	// player entered previously.
+	Prompt(ctx context.Context, username string, code int) (instructions string, err error)
+
+	// Entered returns the last code that the player with the given username
+	// entered, or 0 if it has not entered one (or the last one was
+	// forgotten). The player may enter another code afterwards (i.e. if it
+	// mistyped this one).
+	Entered(ctx context.Context, username string) (int, error)
+
+	// Forget forgets the last code that the player with the given username
+	// entered, so that it cannot be used again.
+	Forget(ctx context.Context, username string) error
+}
+
+// A Challenge is a login in progress, which is completed once its player
+// follows its Instructions (i.e. enters its Code in-game).
+type Challenge struct {
+	// ID identifies the Challenge. It is secret, since it is exchanged for a
+	// Session.
+	ID string `json:"id"`
+
+	Username     string    `json:"username"`
+	Code         int       `json:"code"`
+	Instructions string    `json:"instructions"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// A Session authenticates a player, by way of its Token.
+type Session struct {
+	Username  string    `json:"username"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// A Service logs in players, and authenticates their sessions.
+type Service interface {
+	// Begin begins a login as the player with the given username.
+	//
+	// The number of pending logins is limited per requester (see
+	// WithRequester), or per player if ctx has no requester.
+	Begin(ctx context.Context, username string) (*Challenge, error)
+
+	// Complete exchanges the Challenge with the given ID for a Session, once
+	// its player has entered its code. Until then, it returns ErrPending.
+	Complete(ctx context.Context, id string) (*Session, error)
+
+	// Authenticate returns the username of the player that a session token
+	// authenticates.
+	Authenticate(token string) (string, error)
+
+	// HasRole reports whether the player with the given username has role.
+	HasRole(username string, role Role) bool
+}
+
+type (
+	contextKey          struct{}
+	requesterContextKey struct{}
+)
+
+// WithUsername returns a copy of ctx that is authenticated as the player with
+// the given username.
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, contextKey{}, username)
+}
+
+// Username returns the username of the player that ctx is authenticated as,
+// if any.
+func Username(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(contextKey{}).(string)
+	return username, ok
+}
+
+// WithRequester returns a copy of ctx whose requests are made by requester
+// (i.e. the address of a client).
+func WithRequester(ctx context.Context, requester string) context.Context {
+	return context.WithValue(ctx, requesterContextKey{}, requester)
+}
+
+// Requester returns the requester that ctx is made by, if known.
+func Requester(ctx context.Context) (string, bool) {
+	requester, ok := ctx.Value(requesterContextKey{}).(string)
+	return requester, ok && requester != ""
+}
+
+// Require returns the username of the player that ctx is authenticated as, or
+// ErrUnauthenticated if it is not authenticated.
+func Require(ctx context.Context) (string, error) {
+	username, ok := Username(ctx)
+	if !ok {
+		err := errors.WithStack(ErrUnauthenticated)
+		return "", exthttp.WrapWithHTTPCode(err, http.StatusUnauthorized)
+	}
+	return username, nil
+}
+
+// RequirePlayer returns an error unless ctx is authenticated as the player
+// with the given username.
+func RequirePlayer(ctx context.Context, username string) error {
+	authenticated, err := Require(ctx)
+	if err != nil {
+		return err
+	}
+	if authenticated != username {
+		err := errors.WithStack(ErrForbidden)
+		return exthttp.WrapWithHTTPCode(err, http.StatusForbidden)
+	}
+	return nil
+}
+
+// MiddlewareConfig configures a Middleware.
+type MiddlewareConfig struct {
+	// TrustForwarded determines whether the requester of a request is read
+	// from its X-Forwarded-For header, which should only be trusted behind a
+	// proxy that sets it (such as the gateway).
+	TrustForwarded bool
+}
+
+// Middleware authenticates requests whose Authorization header holds a
+// session token (as a bearer token), using svc. It also records the
+// requester of each request (see Requester).
+//
+// Requests with invalid tokens are treated as unauthenticated, rather than
+// rejected, so that they may still begin a login.
+func Middleware(
+	svc Service,
+	logger log.Logger,
+	next http.Handler,
+	opts ...func(*MiddlewareConfig),
+) http.Handler {
+	var cfg MiddlewareConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requester := remoteHost(r, cfg.TrustForwarded)
+		r = r.WithContext(WithRequester(r.Context(), requester))
+
+		ctx, err := Authorize(r.Context(), svc, r.Header.Get("Authorization"))
+		if err != nil {
+			l := log.With(logger, "remote", requester)
+			logutil.Log(logutil.WithError(l, err), "invalid session token")
+		} else {
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// remoteHost returns the host of the client that made r. If trustForwarded
+// is set, it is the address that the proxy in front of the server appended
+// to the X-Forwarded-For header of r.
+func remoteHost(r *http.Request, trustForwarded bool) string {
+	if trustForwarded {
+		forwarded := r.Header.Values("X-Forwarded-For")
+		if n := len(forwarded); n > 0 {
+			hosts := strings.Split(forwarded[n-1], ",")
+			if host := strings.TrimSpace(hosts[len(hosts)-1]); host != "" {
+				return host
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Authorize returns a copy of ctx that is authenticated by the session token
+// in authorization (the value of an Authorization header), using svc.
+//
+// If authorization does not hold a bearer token, ctx is returned as-is.
+func Authorize(
+	ctx context.Context,
+	svc Service,
+	authorization string,
+) (context.Context, error) {
+	token := BearerToken(authorization)
+	if token == "" {
+		return ctx, nil
+	}
+	username, err := svc.Authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+	return WithUsername(ctx, username), nil
+}
+
+// BearerToken returns the token in the value of an Authorization header that
+// uses the "Bearer" scheme, or an empty string if it uses another scheme.
+func BearerToken(authorization string) string {
+	const prefix = "Bearer "
+	if len(authorization) < len(prefix) ||
+		!strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(prefix):])
+}
+
+var (
+	// ErrPending is returned when a Challenge has yet to be completed.
+	ErrPending = stderrors.New("auth: challenge pending")
+
+	// ErrChallengeNotFound is returned when a Challenge does not exist, or
+	// has expired.
+	ErrChallengeNotFound = stderrors.New("auth: challenge not found")
+
+	// ErrInvalidToken is returned when a session token is malformed,
+	// forged, or expired.
+	ErrInvalidToken = stderrors.New("auth: invalid token")
+
+	// ErrUnauthenticated is returned when a request that must be
+	// authenticated is not.
+	ErrUnauthenticated = stderrors.New("auth: not authenticated")
+
+	// ErrForbidden is returned when a request acts on behalf of a player
+	// other than the one that it is authenticated as, or requires a Role
+	// that its player lacks.
+	ErrForbidden = stderrors.New("auth: forbidden")
+)
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Role grants a player permissions beyond acting on its own behalf.
+type Role string
+
+// The set of valid Roles.
+const (
+	// RoleAdmin players manage the rooms and zones of the world, and message
+	// other players.
+	RoleAdmin Role = "ADMIN"
+)
+
+// Roles returns the set of valid Roles.
+func Roles() []Role { return []Role{RoleAdmin} }
+
+// Validate returns an error if r is not a valid Role.
+func (r Role) Validate() error {
+	switch r {
+	case RoleAdmin:
+		return nil
+	default:
+		err := errors.Newf("auth: invalid role '%s'", string(r))
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+}
+
+func (r Role) String() string { return string(r) }
+
+var (
+	_ graphql.Marshaler   = (*Role)(nil)
+	_ graphql.Unmarshaler = (*Role)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (r Role) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(r)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (r *Role) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		err := errors.Newf("auth: unsupported field type %T", v)
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	if err := Role(s).Validate(); err != nil {
+		return err
+	}
+	*r = Role(s)
+	return nil
+}
+
+// RequireRole returns the username of the player that ctx is authenticated
+// as, or an error unless svc reports that the player has role.
+func RequireRole(ctx context.Context, svc Service, role Role) (string, error) {
+	username, err := Require(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !svc.HasRole(username, role) {
+		err := errors.WithStack(ErrForbidden)
+		return "", exthttp.WrapWithHTTPCode(err, http.StatusForbidden)
+	}
+	return username, nil
+}
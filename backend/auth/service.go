@@ -0,0 +1,396 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// Codes are 6-digit numbers, so that they are easy to type, and fit within a
+// Minecraft score.
+const (
+	minCode = 100000
+	maxCode = 999999
+)
+
+type service struct {
+	verifier Verifier
+	secret   []byte
+	cfg      Config
+	logger   log.Logger
+
+	mu         sync.Mutex
+	challenges map[string]*challenge  // by ID
+	players    map[string]*playerLock // by username
+}
+
+// A playerLock serializes the calls to the verifier that prompt a player, or
+// forget its codes, so that a code is never forgotten while another login of
+// the player begins.
+type playerLock struct {
+	sync.Mutex
+	refs int // the number of callers holding or waiting for the lock
+}
+
+type challenge struct {
+	Challenge
+	requester string    // who began the challenge, if known
+	polled    time.Time // when the verifier was last checked
+	entered   bool
+}
+
+// Config configures a Service.
+type Config struct {
+	Now func() time.Time
+
+	// ChallengeTTL is how long players have to complete a Challenge.
+	ChallengeTTL time.Duration
+
+	// SessionTTL is how long a Session lasts.
+	SessionTTL time.Duration
+
+	// PollInterval is the minimum time between checks of whether the player
+	// of a Challenge has entered its code.
+	PollInterval time.Duration
+
+	// MaxChallenges is the maximum number of pending challenges per
+	// requester, which limits the odds of an attacker's code being entered
+	// by chance.
+	//
+	// Challenges are not limited per player, so that others cannot lock a
+	// player out by beginning logins on its behalf. Challenges whose contexts
+	// have no requester are limited per player instead.
+	MaxChallenges int
+
+	// Admins are the usernames of the players with RoleAdmin.
+	Admins []string
+}
+
+// NewService creates a Service that verifies players with verifier, and signs
+// session tokens with secret.
+func NewService(
+	verifier Verifier,
+	secret []byte,
+	logger log.Logger,
+	opts ...func(*Config),
+) Service {
+	cfg := Config{
+		Now:           time.Now,
+		ChallengeTTL:  5 * time.Minute,
+		SessionTTL:    7 * 24 * time.Hour,
+		PollInterval:  time.Second,
+		MaxChallenges: 3,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &service{
+		verifier:   verifier,
+		secret:     secret,
+		cfg:        cfg,
+		logger:     level.NewInjector(logger, level.DebugValue()),
+		challenges: make(map[string]*challenge),
+		players:    make(map[string]*playerLock),
+	}
+}
+
+func (svc *service) Begin(ctx context.Context, username string) (_ *Challenge, err error) {
+	requester, _ := Requester(ctx)
+	logger := log.With(svc.logger, "username", username, "requester", requester)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Begin", err)
+	}(time.Now())
+
+	if username == "" {
+		err := errors.New("auth: empty username")
+		return nil, exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	id, err := randomID()
+	if err != nil {
+		return nil, err
+	}
+	code, err := randomCode()
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := svc.lockPlayer(username)
+	defer unlock()
+
+	// Check the number of pending challenges before prompting the player, so
+	// that the verifier is not flooded with requests.
+	svc.mu.Lock()
+	err = svc.checkPending(requester, username)
+	svc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	instructions, err := svc.verifier.Prompt(ctx, username, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "auth: prompt player")
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	c := &challenge{
+		Challenge: Challenge{
+			ID:           id,
+			Username:     username,
+			Code:         code,
+			Instructions: instructions,
+			ExpiresAt:    svc.cfg.Now().Add(svc.cfg.ChallengeTTL),
+		},
+		requester: requester,
+	}
+	svc.challenges[id] = c
+	clone := c.Challenge
+	return &clone, nil
+}
+
+func (svc *service) Complete(ctx context.Context, id string) (_ *Session, err error) {
+	defer func(start time.Time) {
+		l := log.With(svc.logger, "took", time.Since(start))
+		logutil.Trace(l, "Complete", err)
+	}(time.Now())
+
+	c, poll, err := svc.challenge(id)
+	if err != nil {
+		return nil, err
+	}
+	if poll {
+		code, err := svc.verifier.Entered(ctx, c.Username)
+		if err != nil {
+			return nil, errors.Wrap(err, "auth: check entered code")
+		}
+		if code != 0 {
+			svc.enter(c.Username, code)
+		}
+	}
+
+	svc.mu.Lock()
+	entered := c.entered
+	svc.mu.Unlock()
+	if !entered {
+		return nil, errors.WithStack(ErrPending)
+	}
+
+	if err = svc.consume(ctx, c); err != nil {
+		return nil, err
+	}
+
+	session := Session{
+		Username:  c.Username,
+		ExpiresAt: svc.cfg.Now().Add(svc.cfg.SessionTTL).Truncate(time.Second),
+	}
+	session.Token, err = signToken(svc.secret, claims{
+		Username:  session.Username,
+		ExpiresAt: session.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (svc *service) Authenticate(token string) (string, error) {
+	c, err := parseToken(svc.secret, token, svc.cfg.Now())
+	if err != nil {
+		return "", err
+	}
+	return c.Username, nil
+}
+
+func (svc *service) HasRole(username string, role Role) bool {
+	if role != RoleAdmin {
+		return false
+	}
+	for _, admin := range svc.cfg.Admins {
+		if admin == username {
+			return true
+		}
+	}
+	return false
+}
+
+// challenge returns the challenge with the given ID, and whether the verifier
+// should be checked for its code.
+func (svc *service) challenge(id string) (_ *challenge, poll bool, err error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.prune()
+
+	c, ok := svc.challenges[id]
+	if !ok {
+		err := errors.WithStack(ErrChallengeNotFound)
+		return nil, false, exthttp.WrapWithHTTPCode(err, http.StatusNotFound)
+	}
+	now := svc.cfg.Now()
+	if c.entered || now.Sub(c.polled) < svc.cfg.PollInterval {
+		return c, false, nil
+	}
+	c.polled = now
+	return c, true, nil
+}
+
+// consume removes c, which must have been entered. The verifier then forgets
+// the code of c, unless its player has other pending challenges (whose codes
+// it may have entered since).
+func (svc *service) consume(ctx context.Context, c *challenge) error {
+	unlock := svc.lockPlayer(c.Username)
+	defer unlock()
+
+	svc.mu.Lock()
+	if _, ok := svc.challenges[c.ID]; !ok { // consumed concurrently
+		svc.mu.Unlock()
+		err := errors.WithStack(ErrChallengeNotFound)
+		return exthttp.WrapWithHTTPCode(err, http.StatusNotFound)
+	}
+	delete(svc.challenges, c.ID)
+	forget := svc.pending(c.Username) == 0
+	svc.mu.Unlock()
+
+	// The session is valid even if the code cannot be forgotten, since the
+	// code cannot complete challenges other than c (unless they were given
+	// the same code by chance).
+	if forget {
+		if err := svc.verifier.Forget(ctx, c.Username); err != nil {
+			l := log.With(svc.logger, "username", c.Username)
+			l = level.Warn(logutil.WithError(l, err))
+			logutil.Log(l, "failed to forget entered code")
+		}
+	}
+	return nil
+}
+
+// lockPlayer locks the playerLock of the player with the given username, and
+// returns a function that unlocks it.
+func (svc *service) lockPlayer(username string) (unlock func()) {
+	svc.mu.Lock()
+	l, ok := svc.players[username]
+	if !ok {
+		l = new(playerLock)
+		svc.players[username] = l
+	}
+	l.refs++
+	svc.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		svc.mu.Lock()
+		defer svc.mu.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(svc.players, username)
+		}
+	}
+}
+
+// enter marks the pending challenge of the player with the given username
+// whose code is code (if any) as entered.
+func (svc *service) enter(username string, code int) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, c := range svc.challenges {
+		if c.Username == username && c.Code == code {
+			c.entered = true
+			return
+		}
+	}
+}
+
+// checkPending returns an error if requester has too many pending
+// challenges, or if requester is empty and the player with the given
+// username has too many pending challenges.
+//
+// svc.mu must be held by the caller.
+func (svc *service) checkPending(requester, username string) error {
+	var (
+		n    int
+		what string
+	)
+	if requester != "" {
+		n, what = svc.requested(requester), "requester"
+	} else {
+		n, what = svc.pending(username), "player"
+	}
+	if n >= svc.cfg.MaxChallenges {
+		err := errors.Newf(
+			"auth: %s has too many pending logins (max %d)",
+			what, svc.cfg.MaxChallenges,
+		)
+		return exthttp.WrapWithHTTPCode(err, http.StatusTooManyRequests)
+	}
+	return nil
+}
+
+// requested returns the number of pending challenges begun by requester.
+//
+// svc.mu must be held by the caller.
+func (svc *service) requested(requester string) int {
+	svc.prune()
+
+	var n int
+	for _, c := range svc.challenges {
+		if c.requester == requester {
+			n++
+		}
+	}
+	return n
+}
+
+// pending returns the number of pending challenges of the player with the
+// given username.
+//
+// svc.mu must be held by the caller.
+func (svc *service) pending(username string) int {
+	svc.prune()
+
+	var n int
+	for _, c := range svc.challenges {
+		if c.Username == username {
+			n++
+		}
+	}
+	return n
+}
+
+// prune removes expired challenges.
+//
+// svc.mu must be held by the caller.
+func (svc *service) prune() {
+	now := svc.cfg.Now()
+	for id, c := range svc.challenges {
+		if !now.Before(c.ExpiresAt) {
+			delete(svc.challenges, id)
+		}
+	}
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "auth: generate ID")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func randomCode() (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(maxCode-minCode+1))
+	if err != nil {
+		return 0, errors.Wrap(err, "auth: generate code")
+	}
+	return minCode + int(n.Int64()), nil
+}
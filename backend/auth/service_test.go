@@ -0,0 +1,285 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// fakeVerifier is a Verifier whose players enter codes with enter.
+type fakeVerifier struct {
+	mu      sync.Mutex
+	entered map[string]int
+	forgets int
+}
+
+var _ Verifier = (*fakeVerifier)(nil)
+
+func newFakeVerifier() *fakeVerifier {
+	return &fakeVerifier{entered: make(map[string]int)}
+}
+
+func (v *fakeVerifier) Prompt(_ context.Context, _ string, code int) (string, error) {
+	return fmt.Sprintf("enter %d", code), nil
+}
+
+func (v *fakeVerifier) Entered(_ context.Context, username string) (int, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.entered[username], nil
+}
+
+func (v *fakeVerifier) Forget(_ context.Context, username string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.entered, username)
+	v.forgets++
+	return nil
+}
+
+// enter enters code as the player with the given username.
+func (v *fakeVerifier) enter(username string, code int) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.entered[username] = code
+}
+
+func (v *fakeVerifier) forgotten() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.forgets
+}
+
+// fakeClock is a clock that only moves when advanced.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newService(opts ...func(*Config)) (Service, *fakeVerifier, *fakeClock) {
+	var (
+		v     = newFakeVerifier()
+		clock = &fakeClock{now: time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)}
+	)
+	opts = append([]func(*Config){
+		func(cfg *Config) { cfg.Now = clock.Now },
+	}, opts...)
+	svc := NewService(v, []byte("secret"), log.NewNopLogger(), opts...)
+	return svc, v, clock
+}
+
+func begin(t *testing.T, svc Service, username string) *Challenge {
+	t.Helper()
+	c, err := svc.Begin(context.Background(), username)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	return c
+}
+
+// complete completes the challenge with the given ID after the poll interval
+// has passed.
+func complete(t *testing.T, svc Service, clock *fakeClock, id string) (*Session, error) {
+	t.Helper()
+	clock.Advance(time.Second)
+	return svc.Complete(context.Background(), id)
+}
+
+func expectCode(t *testing.T, err error, target error, code int) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Fatalf("expected %v, got %v", target, err)
+	}
+	if got := exthttp.GetHTTPCode(err, 0); got != code {
+		t.Fatalf("expected HTTP code %d, got %d", code, got)
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	svc, v, clock := newService()
+	start := clock.Now()
+
+	c := begin(t, svc, "Steve")
+	if c.Username != "Steve" || c.Code < minCode || c.Code > maxCode {
+		t.Fatalf("unexpected challenge %+v", c)
+	}
+	if want := fmt.Sprintf("enter %d", c.Code); c.Instructions != want {
+		t.Errorf("expected instructions %q, got %q", want, c.Instructions)
+	}
+	if want := start.Add(5 * time.Minute); !c.ExpiresAt.Equal(want) {
+		t.Errorf("expected challenge to expire at %v, got %v", want, c.ExpiresAt)
+	}
+
+	// Challenges are pending until their codes are entered.
+	if _, err := complete(t, svc, clock, c.ID); !errors.Is(err, ErrPending) {
+		t.Fatalf("expected ErrPending, got %v", err)
+	}
+	v.enter("Steve", c.Code+1)
+	if _, err := complete(t, svc, clock, c.ID); !errors.Is(err, ErrPending) {
+		t.Fatalf("expected ErrPending after a wrong code, got %v", err)
+	}
+
+	// The verifier is polled at most once per PollInterval.
+	v.enter("Steve", c.Code)
+	if _, err := svc.Complete(context.Background(), c.ID); !errors.Is(err, ErrPending) {
+		t.Fatalf("expected ErrPending before the poll interval, got %v", err)
+	}
+	s, err := complete(t, svc, clock, c.ID)
+	if err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if s.Username != "Steve" {
+		t.Errorf("expected session for Steve, got %q", s.Username)
+	}
+	if want := clock.Now().Add(7 * 24 * time.Hour); !s.ExpiresAt.Equal(want) {
+		t.Errorf("expected session to expire at %v, got %v", want, s.ExpiresAt)
+	}
+	username, err := svc.Authenticate(s.Token)
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if username != "Steve" {
+		t.Errorf("expected token to authenticate Steve, got %q", username)
+	}
+
+	// Challenges are completed once, and their codes are forgotten.
+	_, err = complete(t, svc, clock, c.ID)
+	expectCode(t, err, ErrChallengeNotFound, http.StatusNotFound)
+	if n := v.forgotten(); n != 1 {
+		t.Errorf("expected the code to be forgotten once, got %d", n)
+	}
+}
+
+func TestChallengeExpiry(t *testing.T) {
+	svc, v, clock := newService()
+
+	c := begin(t, svc, "Steve")
+	clock.Advance(5 * time.Minute)
+	v.enter("Steve", c.Code)
+	_, err := svc.Complete(context.Background(), c.ID)
+	expectCode(t, err, ErrChallengeNotFound, http.StatusNotFound)
+}
+
+func TestSessionExpiry(t *testing.T) {
+	svc, v, clock := newService(func(cfg *Config) { cfg.SessionTTL = time.Hour })
+
+	c := begin(t, svc, "Steve")
+	v.enter("Steve", c.Code)
+	s, err := complete(t, svc, clock, c.ID)
+	if err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	clock.Advance(time.Hour - time.Second)
+	if _, err = svc.Authenticate(s.Token); err != nil {
+		t.Fatalf("authenticate before expiry: %v", err)
+	}
+	clock.Advance(time.Second)
+	_, err = svc.Authenticate(s.Token)
+	expectCode(t, err, ErrInvalidToken, http.StatusUnauthorized)
+
+	// Tokens are bound to the secret that signed them.
+	other := NewService(v, []byte("other"), log.NewNopLogger())
+	_, err = other.Authenticate(s.Token)
+	expectCode(t, err, ErrInvalidToken, http.StatusUnauthorized)
+}
+
+func TestMaxChallenges(t *testing.T) {
+	svc, _, clock := newService(func(cfg *Config) { cfg.MaxChallenges = 2 })
+
+	begin(t, svc, "Steve")
+	begin(t, svc, "Steve")
+	_, err := svc.Begin(context.Background(), "Steve")
+	if code := exthttp.GetHTTPCode(err, 0); code != http.StatusTooManyRequests {
+		t.Fatalf("expected HTTP code %d, got %d (%v)", http.StatusTooManyRequests, code, err)
+	}
+
+	// Without a requester, the limit is per player, and expired challenges
+	// do not count.
+	begin(t, svc, "Alex")
+	clock.Advance(5 * time.Minute)
+	begin(t, svc, "Steve")
+}
+
+// An attacker that begins logins for a player must not lock it out of its
+// own login.
+func TestMaxChallengesPerRequester(t *testing.T) {
+	svc, v, clock := newService(func(cfg *Config) { cfg.MaxChallenges = 2 })
+	var (
+		attacker = WithRequester(context.Background(), "203.0.113.7")
+		player   = WithRequester(context.Background(), "198.51.100.1")
+	)
+	for i := 0; i < 2; i++ {
+		if _, err := svc.Begin(attacker, "Steve"); err != nil {
+			t.Fatalf("begin: %v", err)
+		}
+	}
+	_, err := svc.Begin(attacker, "Alex")
+	if code := exthttp.GetHTTPCode(err, 0); code != http.StatusTooManyRequests {
+		t.Fatalf("expected HTTP code %d, got %d (%v)", http.StatusTooManyRequests, code, err)
+	}
+
+	c, err := svc.Begin(player, "Steve")
+	if err != nil {
+		t.Fatalf("expected the player to begin its own login, got %v", err)
+	}
+	v.enter("Steve", c.Code)
+	if _, err := complete(t, svc, clock, c.ID); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+}
+
+// An attacker that begins a login for a player must not make it lose the code
+// that it entered for its own login.
+func TestConcurrentChallenges(t *testing.T) {
+	svc, v, clock := newService()
+
+	own := begin(t, svc, "Steve")
+	v.enter("Steve", own.Code)
+	other := begin(t, svc, "Steve")
+
+	if _, err := complete(t, svc, clock, own.ID); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if n := v.forgotten(); n != 0 {
+		t.Fatalf("expected no codes to be forgotten while a login is pending, got %d", n)
+	}
+
+	v.enter("Steve", other.Code)
+	if _, err := complete(t, svc, clock, other.ID); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if n := v.forgotten(); n != 1 {
+		t.Fatalf("expected the code to be forgotten once, got %d", n)
+	}
+}
+
+func TestHasRole(t *testing.T) {
+	svc, _, _ := newService(func(cfg *Config) { cfg.Admins = []string{"Notch"} })
+	if !svc.HasRole("Notch", RoleAdmin) {
+		t.Error("expected Notch to be an admin")
+	}
+	if svc.HasRole("Steve", RoleAdmin) {
+		t.Error("expected Steve not to be an admin")
+	}
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/store"
+)
+
+// Session tokens are of the form "<claims>.<signature>", where claims is the
+// JSON encoding of the claims, and signature is its HMAC-SHA256. Both parts
+// are encoded with unpadded URL-safe base64.
+var encoding = base64.RawURLEncoding
+
+type claims struct {
+	Username  string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// signToken creates a session token with the given claims.
+func signToken(secret []byte, c claims) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrap(err, "auth: encode claims")
+	}
+	payload := encoding.EncodeToString(data)
+	return payload + "." + encoding.EncodeToString(sign(secret, payload)), nil
+}
+
+// parseToken returns the claims of a session token, if it was signed with
+// secret and has yet to expire.
+func parseToken(secret []byte, token string, now time.Time) (claims, error) {
+	var c claims
+	err := func() error {
+		i := strings.LastIndexByte(token, '.')
+		if i < 0 {
+			return errors.New("auth: malformed token")
+		}
+		payload := token[:i]
+		sig, err := encoding.DecodeString(token[i+1:])
+		if err != nil {
+			return errors.Wrap(err, "auth: decode signature")
+		}
+		if !hmac.Equal(sig, sign(secret, payload)) {
+			return errors.New("auth: bad signature")
+		}
+		data, err := encoding.DecodeString(payload)
+		if err != nil {
+			return errors.Wrap(err, "auth: decode claims")
+		}
+		if err = json.Unmarshal(data, &c); err != nil {
+			return errors.Wrap(err, "auth: decode claims")
+		}
+		if c.Username == "" || !now.Before(time.Unix(c.ExpiresAt, 0)) {
+			return errors.New("auth: token expired")
+		}
+		return nil
+	}()
+	if err != nil {
+		err = errors.Mark(err, ErrInvalidToken)
+		return claims{}, exthttp.WrapWithHTTPCode(err, http.StatusUnauthorized)
+	}
+	return c, nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// LoadSecret returns the secret that session tokens are signed with, which is
+// kept in repo. If repo does not hold a secret, a random one is generated and
+// saved to it, so that sessions survive restarts.
+func LoadSecret(ctx context.Context, repo store.Collection) ([]byte, error) {
+	const key = "secret"
+	var doc struct {
+		Secret []byte `json:"secret"`
+	}
+	err := repo.Get(ctx, key, &doc)
+	if err == nil {
+		return doc.Secret, nil
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return nil, errors.Wrap(err, "auth: load secret")
+	}
+
+	doc.Secret = make([]byte, 32)
+	if _, err = rand.Read(doc.Secret); err != nil {
+		return nil, errors.Wrap(err, "auth: generate secret")
+	}
+	if err = repo.Put(ctx, key, &doc); err != nil {
+		return nil, errors.Wrap(err, "auth: save secret")
+	}
+	return doc.Secret, nil
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+func TestToken(t *testing.T) {
+	var (
+		secret = []byte("secret")
+		now    = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		exp    = now.Add(time.Hour)
+	)
+	token, err := signToken(secret, claims{Username: "Steve", ExpiresAt: exp.Unix()})
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	c, err := parseToken(secret, token, now)
+	if err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	if want := (claims{Username: "Steve", ExpiresAt: exp.Unix()}); c != want {
+		t.Errorf("expected claims %+v, got %+v", want, c)
+	}
+
+	// Claims cannot be changed without the secret.
+	forged, err := signToken(secret, claims{Username: "Notch", ExpiresAt: exp.Unix()})
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	forged = forged[:strings.IndexByte(forged, '.')] +
+		token[strings.IndexByte(token, '.'):]
+
+	empty, err := signToken(secret, claims{ExpiresAt: exp.Unix()})
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		token  string
+		secret []byte
+		now    time.Time
+	}{
+		{name: "other secret", token: token, secret: []byte("other"), now: now},
+		{name: "forged claims", token: forged, secret: secret, now: now},
+		{name: "expired", token: token, secret: secret, now: exp},
+		{name: "empty username", token: empty, secret: secret, now: now},
+		{name: "no signature", token: strings.Split(token, ".")[0], secret: secret, now: now},
+		{name: "bad encoding", token: token + "!", secret: secret, now: now},
+		{name: "empty", token: "", secret: secret, now: now},
+	} {
+		_, err := parseToken(tc.secret, tc.token, tc.now)
+		if !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected ErrInvalidToken, got %v", tc.name, err)
+			continue
+		}
+		if code := exthttp.GetHTTPCode(err, 0); code != http.StatusUnauthorized {
+			t.Errorf("%s: expected HTTP code %d, got %d", tc.name, http.StatusUnauthorized, code)
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	for header, want := range map[string]string{
+		"Bearer abc.def":  "abc.def",
+		"bearer abc.def ": "abc.def",
+		"Basic abc.def":   "",
+		"Bearer":          "",
+		"":                "",
+	} {
+		if got := BearerToken(header); got != want {
+			t.Errorf("BearerToken(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestMiddlewareRequester(t *testing.T) {
+	svc, _, _ := newService()
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		forwarded      []string
+		trustForwarded bool
+		want           string
+	}{
+		{name: "remote", remoteAddr: "198.51.100.1:52100", want: "198.51.100.1"},
+		{name: "remote ipv6", remoteAddr: "[2001:db8::1]:52100", want: "2001:db8::1"},
+		{
+			name:       "untrusted forwarded",
+			remoteAddr: "127.0.0.1:52100",
+			forwarded:  []string{"203.0.113.7"},
+			want:       "127.0.0.1",
+		},
+		{
+			name:           "trusted forwarded",
+			remoteAddr:     "127.0.0.1:52100",
+			forwarded:      []string{"203.0.113.7, 198.51.100.1"},
+			trustForwarded: true,
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "trusted forwarded headers",
+			remoteAddr:     "127.0.0.1:52100",
+			forwarded:      []string{"203.0.113.7", "198.51.100.1"},
+			trustForwarded: true,
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "trusted without forwarded",
+			remoteAddr:     "127.0.0.1:52100",
+			trustForwarded: true,
+			want:           "127.0.0.1",
+		},
+	}
+	for _, test := range tests {
+		var got string
+		h := Middleware(
+			svc, log.NewNopLogger(),
+			http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got, _ = Requester(r.Context())
+			}),
+			func(cfg *MiddlewareConfig) { cfg.TrustForwarded = test.trustForwarded },
+		)
+		r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		r.RemoteAddr = test.remoteAddr
+		for _, f := range test.forwarded {
+			r.Header.Add("X-Forwarded-For", f)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		if got != test.want {
+			t.Errorf("%s: expected requester %q, got %q", test.name, test.want, got)
+		}
+	}
+}
@@ -13,6 +13,7 @@ require (
 	github.com/go-logfmt/logfmt v0.5.0
 	github.com/gogo/protobuf v1.3.1 // indirect
 	github.com/gorcon/rcon v1.0.0
+	github.com/gorilla/websocket v1.2.0
 	github.com/joho/godotenv v1.3.0
 	github.com/kr/pretty v0.2.0 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
@@ -0,0 +1,75 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/cockroachdb/errors/exthttp"
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+func (r *mutationResolver) Login(ctx context.Context, username string) (*auth.Challenge, error) {
+	if r.Resolver.Auth == nil {
+		return nil, errAuthDisabled()
+	}
+
+	// Only online players can enter codes.
+	if _, err := r.Resolver.Presence.Get(ctx, username); err != nil {
+		if errors.Is(err, presence.ErrNotFound) {
+			err := errors.New("graphql: player is not online")
+			return nil, exthttp.WrapWithHTTPCode(err, http.StatusNotFound)
+		}
+		return nil, err
+	}
+	return r.Resolver.Auth.Begin(ctx, username)
+}
+
+func (r *mutationResolver) CompleteLogin(ctx context.Context, id string) (*auth.Session, error) {
+	if r.Resolver.Auth == nil {
+		return nil, errAuthDisabled()
+	}
+	s, err := r.Resolver.Auth.Complete(ctx, id)
+	if err != nil {
+		if errors.Is(err, auth.ErrPending) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *queryResolver) AuthRequired(ctx context.Context) (bool, error) {
+	return r.Resolver.Auth != nil, nil
+}
+
+func (r *queryResolver) Viewer(ctx context.Context) (*string, error) {
+	if username, ok := auth.Username(ctx); ok {
+		return &username, nil
+	}
+	return nil, nil
+}
+
+func (r *queryResolver) ViewerRoles(ctx context.Context) ([]auth.Role, error) {
+	roles := auth.Roles()
+
+	// Without authentication, everyone may do anything.
+	if r.Resolver.Auth == nil {
+		return roles, nil
+	}
+	username, ok := auth.Username(ctx)
+	if !ok {
+		return []auth.Role{}, nil
+	}
+	held := make([]auth.Role, 0, len(roles))
+	for _, role := range roles {
+		if r.Resolver.Auth.HasRole(username, role) {
+			held = append(held, role)
+		}
+	}
+	return held, nil
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+)
+
+// Authenticated implements the @authenticated directive.
+//
+// If r.Auth is nil, authentication is disabled, and all requests are allowed.
+func (r *Resolver) Authenticated(
+	ctx context.Context,
+	_ interface{},
+	next graphql.Resolver,
+	self *string,
+	role *auth.Role,
+) (interface{}, error) {
+	if r.Auth == nil {
+		return next(ctx)
+	}
+	if role != nil {
+		if _, err := auth.RequireRole(ctx, r.Auth, *role); err != nil {
+			return nil, err
+		}
+	}
+	if self == nil {
+		if _, err := auth.Require(ctx); err != nil {
+			return nil, err
+		}
+		return next(ctx)
+	}
+	username, _ := graphql.GetFieldContext(ctx).Args[*self].(string)
+	if err := auth.RequirePlayer(ctx, username); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// requireOwner returns an error unless ctx is authenticated as the player with
+// the username owner, or as an admin.
+//
+// If r.Auth is nil, authentication is disabled, and all requests are allowed.
+func (r *Resolver) requireOwner(ctx context.Context, owner string) error {
+	if r.Auth == nil {
+		return nil
+	}
+	username, err := auth.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if owner != "" && username == owner {
+		return nil
+	}
+	_, err = auth.RequireRole(ctx, r.Auth, auth.RoleAdmin)
+	return err
+}
+
+// errAuthDisabled is returned by login operations when authentication is
+// disabled.
+func errAuthDisabled() error {
+	err := errors.New("graphql: authentication is disabled")
+	return exthttp.WrapWithHTTPCode(err, http.StatusNotImplemented)
+}
@@ -0,0 +1,645 @@
+package graphql_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/graphql"
+	"go.stevenxie.me/zoomcraft/backend/graphql/graphqlutil"
+	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/sim"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/presence/scripted"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/settings"
+	"go.stevenxie.me/zoomcraft/backend/store"
+	"go.stevenxie.me/zoomcraft/backend/zones"
+)
+
+const simPassword = "minecraft"
+
+// simActors are the players in the simulated world, who stand still so that
+// their positions are known.
+var simActors = []scripted.Actor{
+	{
+		ID:     "Steve",
+		Space:  minecraft.DimensionOverworld.String(),
+		Script: scripted.Still(presence.Position{X: 0.5, Y: 64, Z: 0.5}, presence.Orientation{}),
+	},
+	{
+		ID:     "Alex",
+		Space:  minecraft.DimensionOverworld.String(),
+		Script: scripted.Still(presence.Position{X: 3.5, Y: 64, Z: 4.5}, presence.Orientation{Yaw: 90}),
+	},
+	{
+		ID:     "Notch",
+		Space:  minecraft.DimensionOverworld.String(),
+		Script: scripted.Still(presence.Position{X: 100, Y: 70, Z: -40}, presence.Orientation{}),
+	},
+	{
+		ID:     "Jeb",
+		Space:  minecraft.DimensionNether.String(),
+		Script: scripted.Still(presence.Position{X: -17, Y: 40, Z: 33}, presence.Orientation{Yaw: -45, Pitch: 30}),
+	},
+}
+
+// newServer serves the GraphQL API for a simulated world, through the same
+// services as in production (except that authentication is disabled, unless
+// an opt sets the resolver's Auth).
+func newServer(t *testing.T, opts ...func(*graphql.Resolver)) http.Handler {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	logger := log.NewNopLogger()
+
+	// Serve simulated world over RCON.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	simulator := sim.NewServer(sim.New(simActors), simPassword, logger)
+	go simulator.Serve(ctx, lis)
+
+	client := minecraft.NewClient(lis.Addr().String(), simPassword)
+	if err = client.Connect(); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	origin := minecraft.NewPlayerService(client, logger)
+
+	watcher := presence.NewWatcher(
+		minecraft.NewProvider(origin),
+		10*time.Millisecond,
+		logger,
+	)
+	go watcher.Run(ctx)
+
+	db, err := store.NewFileStore("")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	roomService, err := rooms.NewService(
+		ctx, db.Collection("rooms"), logger,
+		func(cfg *rooms.Config) { cfg.ValidateUsername = command.ValidateUsername },
+	)
+	if err != nil {
+		t.Fatalf("create room service: %v", err)
+	}
+	zoneService, err := zones.NewService(ctx, db.Collection("zones"), logger)
+	if err != nil {
+		t.Fatalf("create zone service: %v", err)
+	}
+	settingsService, err := settings.NewService(
+		ctx, db.Collection("settings"), logger,
+		func(cfg *settings.Config) { cfg.ValidateUsername = command.ValidateUsername },
+	)
+	if err != nil {
+		t.Fatalf("create settings service: %v", err)
+	}
+
+	resolver := &graphql.Resolver{
+		Presence: watcher,
+		Feed:     presence.NewFeed(watcher),
+		Rooms:    roomService,
+		Zones:    zoneService,
+		Settings: settingsService,
+		Teleporter: minecraft.NewTeleporter(
+			minecraft.NewTeleportService(client, origin, logger),
+		),
+		Minecraft: &graphql.Minecraft{
+			Server: minecraft.NewServerService(client, logger),
+		},
+	}
+	for _, opt := range opts {
+		opt(resolver)
+	}
+	h := handler.New(graphql.NewExecutableSchema(graphql.Config{
+		Resolvers: resolver,
+		Directives: graphql.DirectiveRoot{
+			Authenticated: resolver.Authenticated,
+		},
+	}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(graphqlutil.PresentError)
+
+	// Wait for the watcher to take its first snapshot.
+	waitFor(t, func() bool {
+		entities, err := watcher.List(ctx)
+		return err == nil && len(entities) == len(simActors)
+	})
+	return h
+}
+
+// waitFor waits up to a second for cond to be true.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+type gqlError struct {
+	Message    string   `json:"message"`
+	Path       []string `json:"path"`
+	Extensions struct {
+		Status struct {
+			Code int `json:"code"`
+		} `json:"status"`
+	} `json:"extensions"`
+}
+
+// do executes query against h, and decodes its data into v. It returns the
+// errors in the response.
+func do(
+	t *testing.T,
+	h http.Handler,
+	query string,
+	vars map[string]interface{},
+	v interface{},
+) []gqlError {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": vars,
+	})
+	if err != nil {
+		t.Fatalf("encode request: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var res struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []gqlError      `json:"errors"`
+	}
+	if err = json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode response %q: %v", rec.Body.String(), err)
+	}
+	if v != nil && len(res.Data) > 0 && string(res.Data) != "null" {
+		if err = json.Unmarshal(res.Data, v); err != nil {
+			t.Fatalf("decode data %s: %v", res.Data, err)
+		}
+	}
+	return res.Errors
+}
+
+// mustDo is like do, but fails if the response contains errors.
+func mustDo(
+	t *testing.T,
+	h http.Handler,
+	query string,
+	vars map[string]interface{},
+	v interface{},
+) {
+	t.Helper()
+	if errs := do(t, h, query, vars, v); len(errs) > 0 {
+		t.Fatalf("query failed: %+v", errs)
+	}
+}
+
+type player struct {
+	Username    string     `json:"username"`
+	Space       string     `json:"space"`
+	Dimension   string     `json:"dimension"`
+	Position    [3]float64 `json:"position"`
+	Orientation [2]float32 `json:"orientation"`
+	Block       [3]int     `json:"block"`
+	Chunk       [2]int     `json:"chunk"`
+}
+
+const playerFields = `
+	username space dimension position orientation block chunk
+`
+
+func TestPlayers(t *testing.T) {
+	h := newServer(t)
+
+	var data struct{ Players []player }
+	mustDo(t, h, `{ players {`+playerFields+`} }`, nil, &data)
+	if len(data.Players) != len(simActors) {
+		t.Fatalf("expected %d players, got %d", len(simActors), len(data.Players))
+	}
+	got := make(map[string]player, len(data.Players))
+	for _, p := range data.Players {
+		got[p.Username] = p
+	}
+	want := map[string]player{
+		"Steve": {
+			Username:  "Steve",
+			Space:     "minecraft:overworld",
+			Dimension: "minecraft:overworld",
+			Position:  [3]float64{0.5, 64, 0.5},
+			Block:     [3]int{0, 64, 0},
+		},
+		"Alex": {
+			Username:    "Alex",
+			Space:       "minecraft:overworld",
+			Dimension:   "minecraft:overworld",
+			Position:    [3]float64{3.5, 64, 4.5},
+			Orientation: [2]float32{90, 0},
+			Block:       [3]int{3, 64, 4},
+		},
+		"Notch": {
+			Username:  "Notch",
+			Space:     "minecraft:overworld",
+			Dimension: "minecraft:overworld",
+			Position:  [3]float64{100, 70, -40},
+			Block:     [3]int{100, 70, -40},
+			Chunk:     [2]int{6, -3},
+		},
+		"Jeb": {
+			Username:    "Jeb",
+			Space:       "minecraft:the_nether",
+			Dimension:   "minecraft:the_nether",
+			Position:    [3]float64{-17, 40, 33},
+			Orientation: [2]float32{-45, 30},
+			Block:       [3]int{-17, 40, 33},
+			Chunk:       [2]int{-2, 2},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("players = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlayersInSpace(t *testing.T) {
+	h := newServer(t)
+
+	// dimension is a deprecated alias of space, which accepts dimensions
+	// without a namespace.
+	for _, args := range []string{
+		`space: "minecraft:the_nether"`,
+		`dimension: "the_nether"`,
+		`dimension: "minecraft:the_nether"`,
+	} {
+		var data struct{ Players []player }
+		mustDo(t, h, `{ players(`+args+`) { username } }`, nil, &data)
+		if len(data.Players) != 1 || data.Players[0].Username != "Jeb" {
+			t.Errorf("players(%s) = %+v, want [Jeb]", args, data.Players)
+		}
+	}
+
+	errs := do(t, h, `{
+		players(space: "minecraft:overworld", dimension: "the_nether") {
+			username
+		}
+	}`, nil, nil)
+	if len(errs) != 1 || errs[0].Extensions.Status.Code != http.StatusBadRequest {
+		t.Errorf("expected a bad request error, got %+v", errs)
+	}
+}
+
+func TestPlayer(t *testing.T) {
+	h := newServer(t)
+
+	var data struct{ Player *player }
+	mustDo(t, h,
+		`query($username: String!) {
+			player(username: $username) {`+playerFields+`}
+		}`,
+		map[string]interface{}{"username": "Alex"},
+		&data,
+	)
+	want := &player{
+		Username:    "Alex",
+		Space:       "minecraft:overworld",
+		Dimension:   "minecraft:overworld",
+		Position:    [3]float64{3.5, 64, 4.5},
+		Orientation: [2]float32{90, 0},
+		Block:       [3]int{3, 64, 4},
+	}
+	if !reflect.DeepEqual(data.Player, want) {
+		t.Errorf("player = %+v, want %+v", data.Player, want)
+	}
+
+	data.Player = nil
+	mustDo(t, h, `{ player(username: "Herobrine") { username } }`, nil, &data)
+	if data.Player != nil {
+		t.Errorf("expected no player, got %+v", data.Player)
+	}
+}
+
+type neighbor struct {
+	Player    struct{ Username string }
+	Distance  float64
+	Direction [3]float64
+}
+
+func TestNeighbors(t *testing.T) {
+	h := newServer(t)
+
+	var data struct {
+		Player struct{ Neighbors []neighbor }
+	}
+	mustDo(t, h, `{
+		player(username: "Steve") {
+			neighbors { player { username } distance direction }
+		}
+	}`, nil, &data)
+
+	// Notch is out of range, and Jeb is in another dimension. Steve faces
+	// south (+Z), so Alex (to the south-east) is ahead and to his left.
+	neighbors := data.Player.Neighbors
+	if len(neighbors) != 1 {
+		t.Fatalf("expected 1 neighbor, got %+v", neighbors)
+	}
+	n := neighbors[0]
+	if n.Player.Username != "Alex" {
+		t.Errorf("neighbor = %q, want Alex", n.Player.Username)
+	}
+	if !approxEqual(n.Distance, 5) {
+		t.Errorf("distance = %v, want 5", n.Distance)
+	}
+	if want := [3]float64{-0.6, 0, 0.8}; !approxEqualVec(n.Direction, want) {
+		t.Errorf("direction = %v, want %v", n.Direction, want)
+	}
+
+	// Everyone in the overworld is within 200 blocks of Steve.
+	mustDo(t, h, `{
+		player(username: "Steve") {
+			neighbors(maxDistance: 200) { player { username } distance direction }
+		}
+	}`, nil, &data)
+	var usernames []string
+	for _, n := range data.Player.Neighbors {
+		usernames = append(usernames, n.Player.Username)
+	}
+	if want := []string{"Alex", "Notch"}; !reflect.DeepEqual(usernames, want) {
+		t.Errorf("neighbors = %q, want %q", usernames, want)
+	}
+}
+
+func TestRelativePosition(t *testing.T) {
+	h := newServer(t)
+
+	var alex struct{ Player struct{ Position [3]float64 } }
+	mustDo(t, h, `{ player(username: "Alex") { position } }`, nil, &alex)
+
+	// Alex is placed relative to Steve like its neighbor direction, scaled by
+	// its distance (see TestNeighbors).
+	var data struct {
+		Player struct{ RelativePosition [3]float64 }
+	}
+	mustDo(t, h,
+		`query($position: Position!) {
+			player(username: "Steve") { relativePosition(position: $position) }
+		}`,
+		map[string]interface{}{"position": alex.Player.Position},
+		&data,
+	)
+	if want := [3]float64{-3, 0, 4}; !approxEqualVec(data.Player.RelativePosition, want) {
+		t.Errorf("relativePosition = %v, want %v", data.Player.RelativePosition, want)
+	}
+}
+
+func TestTeleportPlayer(t *testing.T) {
+	h := newServer(t)
+
+	var data struct{ TeleportPlayer player }
+	mustDo(t, h,
+		`mutation($position: Position!, $orientation: Orientation) {
+			teleportPlayer(
+				username: "Steve"
+				position: $position
+				orientation: $orientation
+			) {`+playerFields+`}
+		}`,
+		map[string]interface{}{
+			"position":    []float64{-3.5, 72, 10.25},
+			"orientation": map[string]float64{"yaw": 180, "pitch": -15},
+		},
+		&data,
+	)
+	want := player{
+		Username:    "Steve",
+		Space:       "minecraft:overworld",
+		Dimension:   "minecraft:overworld",
+		Position:    [3]float64{-3.5, 72, 10.25},
+		Orientation: [2]float32{180, -15},
+		Block:       [3]int{-4, 72, 10},
+		Chunk:       [2]int{-1, 0},
+	}
+	if !reflect.DeepEqual(data.TeleportPlayer, want) {
+		t.Errorf("teleportPlayer = %+v, want %+v", data.TeleportPlayer, want)
+	}
+
+	// The player stays where it was teleported to, once the watcher observes
+	// the move.
+	waitFor(t, func() bool {
+		var data struct{ Player player }
+		mustDo(t, h, `{ player(username: "Steve") {`+playerFields+`} }`, nil, &data)
+		return reflect.DeepEqual(data.Player, want)
+	})
+
+	// Players that are not online cannot be teleported.
+	errs := do(t, h, `mutation {
+		teleportPlayer(username: "Herobrine", position: [0, 64, 0]) { username }
+	}`, nil, nil)
+	if len(errs) != 1 || errs[0].Extensions.Status.Code != http.StatusNotFound {
+		t.Errorf("expected a not found error, got %+v", errs)
+	}
+}
+
+func TestTeleportPlayerTo(t *testing.T) {
+	h := newServer(t)
+
+	var data struct{ TeleportPlayerTo player }
+	mustDo(t, h, `mutation {
+		teleportPlayerTo(username: "Alex", target: "Jeb") {`+playerFields+`}
+	}`, nil, &data)
+	want := player{
+		Username:    "Alex",
+		Space:       "minecraft:the_nether",
+		Dimension:   "minecraft:the_nether",
+		Position:    [3]float64{-17, 40, 33},
+		Orientation: [2]float32{-45, 30},
+		Block:       [3]int{-17, 40, 33},
+		Chunk:       [2]int{-2, 2},
+	}
+	if !reflect.DeepEqual(data.TeleportPlayerTo, want) {
+		t.Errorf("teleportPlayerTo = %+v, want %+v", data.TeleportPlayerTo, want)
+	}
+
+	// Alex is now next to Jeb, in the nether.
+	waitFor(t, func() bool {
+		var data struct{ Players []player }
+		mustDo(t, h, `{ players(dimension: "the_nether") { username } }`, nil, &data)
+		return len(data.Players) == 2
+	})
+}
+
+// Teleports are reserved for admins, even when players teleport themselves.
+func TestTeleportAccess(t *testing.T) {
+	h := newServer(t, func(r *graphql.Resolver) { r.Auth = fakeAuth{} })
+
+	for _, query := range []string{
+		`mutation { teleportPlayer(username: "Alex", position: [0, 64, 0]) { username } }`,
+		`mutation { teleportPlayerTo(username: "Alex", target: "Steve") { username } }`,
+	} {
+		for _, tc := range []struct {
+			name string
+			h    http.Handler
+			code int
+		}{
+			{name: "unauthenticated", h: h, code: http.StatusUnauthorized},
+			{name: "self", h: as(h, "Alex"), code: http.StatusForbidden},
+			{name: "other player", h: as(h, "Notch"), code: http.StatusForbidden},
+		} {
+			errs := do(t, tc.h, query, nil, nil)
+			if len(errs) != 1 || errs[0].Extensions.Status.Code != tc.code {
+				t.Errorf("%s: expected a %d error, got %+v", tc.name, tc.code, errs)
+			}
+		}
+		mustDo(t, as(h, "Steve"), query, nil, nil)
+	}
+}
+
+const epsilon = 1e-9
+
+// fakeAuth is an auth.Service whose only admin is Steve.
+type fakeAuth struct{ auth.Service }
+
+func (fakeAuth) HasRole(username string, role auth.Role) bool {
+	return username == "Steve" && role == auth.RoleAdmin
+}
+
+// as authenticates the requests to h as the player with the given username.
+func as(h http.Handler, username string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(auth.WithUsername(r.Context(), username)))
+	})
+}
+
+func TestAuthenticatedRole(t *testing.T) {
+	h := newServer(t, func(r *graphql.Resolver) { r.Auth = fakeAuth{} })
+
+	const query = `mutation {
+		createZone(input: {
+			name: "Vault"
+			space: "minecraft:overworld"
+			box: { min: [0, 0, 0], max: [10, 10, 10] }
+		}) { id }
+	}`
+	for _, tc := range []struct {
+		name string
+		h    http.Handler
+		code int
+	}{
+		{name: "unauthenticated", h: h, code: http.StatusUnauthorized},
+		{name: "player", h: as(h, "Alex"), code: http.StatusForbidden},
+	} {
+		errs := do(t, tc.h, query, nil, nil)
+		if len(errs) != 1 || errs[0].Extensions.Status.Code != tc.code {
+			t.Errorf("%s: expected a %d error, got %+v", tc.name, tc.code, errs)
+		}
+	}
+
+	var data struct{ CreateZone struct{ ID string } }
+	mustDo(t, as(h, "Steve"), query, nil, &data)
+	if data.CreateZone.ID == "" {
+		t.Error("createZone: empty ID")
+	}
+
+	// Roles are reported to players, so that clients can hide what they
+	// cannot do.
+	for username, want := range map[string][]string{
+		"Steve": {"ADMIN"},
+		"Alex":  {},
+	} {
+		var data struct{ ViewerRoles []string }
+		mustDo(t, as(h, username), `{ viewerRoles }`, nil, &data)
+		if !reflect.DeepEqual(data.ViewerRoles, want) {
+			t.Errorf("%s: viewerRoles = %q, want %q", username, data.ViewerRoles, want)
+		}
+	}
+}
+
+func TestRoomAccess(t *testing.T) {
+	h := newServer(t, func(r *graphql.Resolver) { r.Auth = fakeAuth{} })
+	expectCode := func(name string, errs []gqlError, code int) {
+		t.Helper()
+		if len(errs) != 1 || errs[0].Extensions.Status.Code != code {
+			t.Errorf("%s: expected a %d error, got %+v", name, code, errs)
+		}
+	}
+
+	// Only admins can create rooms with areas.
+	const createMeeting = `mutation {
+		createRoom(name: "Meeting", area: {
+			space: "minecraft:overworld", min: [0, 0, 0], max: [10, 10, 10]
+		}) { id }
+	}`
+	expectCode("createRoom with area", do(t, as(h, "Alex"), createMeeting, nil, nil), http.StatusForbidden)
+	mustDo(t, as(h, "Steve"), createMeeting, nil, nil)
+
+	var data struct {
+		CreateRoom struct {
+			ID    string
+			Owner string
+		}
+	}
+	mustDo(t, as(h, "Alex"), `mutation {
+		createRoom(name: "Call", mode: GLOBAL) { id owner }
+	}`, nil, &data)
+	if data.CreateRoom.Owner != "Alex" {
+		t.Fatalf("expected room to be owned by Alex, got %q", data.CreateRoom.Owner)
+	}
+	vars := map[string]interface{}{"id": data.CreateRoom.ID}
+
+	// Players must be invited by the room's owner (or an admin).
+	const (
+		joinRoom = `mutation($id: ID!, $username: String!) {
+			joinRoom(id: $id, username: $username) { joined }
+		}`
+		inviteToRoom = `mutation($id: ID!, $username: String!) {
+			inviteToRoom(id: $id, username: $username) { invited }
+		}`
+	)
+	vars["username"] = "Notch"
+	expectCode("joinRoom", do(t, as(h, "Notch"), joinRoom, vars, nil), http.StatusForbidden)
+	expectCode("inviteToRoom", do(t, as(h, "Notch"), inviteToRoom, vars, nil), http.StatusForbidden)
+	mustDo(t, as(h, "Alex"), inviteToRoom, vars, nil)
+	mustDo(t, as(h, "Notch"), joinRoom, vars, nil)
+
+	vars["username"] = "Jeb"
+	mustDo(t, as(h, "Steve"), inviteToRoom, vars, nil)
+	var joined struct{ JoinRoom struct{ Joined []string } }
+	mustDo(t, as(h, "Jeb"), joinRoom, vars, &joined)
+	if want := []string{"Notch", "Jeb"}; !reflect.DeepEqual(joined.JoinRoom.Joined, want) {
+		t.Errorf("joined = %q, want %q", joined.JoinRoom.Joined, want)
+	}
+
+	// Usernames must follow Minecraft's rules.
+	vars["username"] = "not a player"
+	expectCode("inviteToRoom", do(t, as(h, "Alex"), inviteToRoom, vars, nil), http.StatusBadRequest)
+}
+
+func approxEqual(a, b float64) bool { return math.Abs(a-b) < epsilon }
+
+func approxEqualVec(a, b [3]float64) bool {
+	for i := range a {
+		if !approxEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
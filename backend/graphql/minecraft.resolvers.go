@@ -5,22 +5,86 @@ package graphql
 
 import (
 	"context"
-	"errors"
 
 	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	mctext "go.stevenxie.me/zoomcraft/backend/minecraft/text"
+	"go.stevenxie.me/zoomcraft/backend/presence"
 )
 
-func (r *queryResolver) Players(ctx context.Context) ([]*minecraft.Player, error) {
-	return r.Resolver.Players.List(ctx)
+func (r *mutationResolver) SendMessage(ctx context.Context, to *string, text string, color *string) (bool, error) {
+	mc, err := r.Resolver.minecraft("sending messages")
+	if err != nil {
+		return false, err
+	}
+	if mc.Messages == nil {
+		return false, presence.UnsupportedError("sending messages")
+	}
+	msg := mctext.Plain(text)
+	if color != nil {
+		c, err := mctext.ParseColor(*color)
+		if err != nil {
+			return false, err
+		}
+		msg = msg.WithColor(c)
+	}
+	if to != nil {
+		err = mc.Messages.Tell(ctx, *to, msg)
+	} else {
+		err = mc.Messages.Broadcast(ctx, msg)
+	}
+	return err == nil, err
+}
+
+func (r *playerResolver) Dimension(ctx context.Context, obj *presence.Entity) (minecraft.Dimension, error) {
+	if _, err := r.Resolver.minecraft("reading dimensions"); err != nil {
+		return "", err
+	}
+	if err := obj.RequirePosition(); err != nil {
+		return "", err
+	}
+	return minecraft.ParseDimension(obj.Space)
+}
+
+func (r *playerResolver) Block(ctx context.Context, obj *presence.Entity) (*minecraft.BlockPosition, error) {
+	if _, err := r.Resolver.minecraft("reading block positions"); err != nil {
+		return nil, err
+	}
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	b := minecraft.CoordinatesOf(obj.Position).Block()
+	return &b, nil
+}
+
+func (r *playerResolver) Chunk(ctx context.Context, obj *presence.Entity) (*minecraft.ChunkPosition, error) {
+	if _, err := r.Resolver.minecraft("reading chunk positions"); err != nil {
+		return nil, err
+	}
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	c := minecraft.CoordinatesOf(obj.Position).Chunk()
+	return &c, nil
 }
 
-func (r *queryResolver) Player(ctx context.Context, username string) (*minecraft.Player, error) {
-	p, err := r.Resolver.Players.Get(ctx, username)
+func (r *playerResolver) Region(ctx context.Context, obj *presence.Entity) (*minecraft.RegionPosition, error) {
+	if _, err := r.Resolver.minecraft("reading region positions"); err != nil {
+		return nil, err
+	}
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	region := minecraft.CoordinatesOf(obj.Position).Region()
+	return &region, nil
+}
+
+func (r *queryResolver) Server(ctx context.Context) (*minecraft.Server, error) {
+	mc, err := r.Resolver.minecraft("reading server status")
 	if err != nil {
-		if errors.Is(err, minecraft.ErrNotFound) {
-			return nil, nil
-		}
 		return nil, err
 	}
-	return p, nil
+	if mc.Server == nil {
+		return nil, presence.UnsupportedError("reading server status")
+	}
+	return mc.Server.Get(ctx)
 }
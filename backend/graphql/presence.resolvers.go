@@ -0,0 +1,159 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/cockroachdb/errors/exthttp"
+	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/zones"
+)
+
+func (r *mutationResolver) TeleportPlayer(ctx context.Context, username string, position presence.Position, orientation *presence.Orientation) (*presence.Entity, error) {
+	if r.Resolver.Teleporter == nil {
+		return nil, presence.UnsupportedError("teleporting players")
+	}
+	return r.Resolver.Teleporter.Teleport(ctx, username, position, orientation)
+}
+
+func (r *mutationResolver) TeleportPlayerTo(ctx context.Context, username string, target string) (*presence.Entity, error) {
+	if r.Resolver.Teleporter == nil {
+		return nil, presence.UnsupportedError("teleporting players")
+	}
+	return r.Resolver.Teleporter.TeleportTo(ctx, username, target)
+}
+
+func (r *playerResolver) Space(ctx context.Context, obj *presence.Entity) (string, error) {
+	if err := obj.RequirePosition(); err != nil {
+		return "", err
+	}
+	return obj.Space, nil
+}
+
+func (r *playerResolver) Position(ctx context.Context, obj *presence.Entity) (*presence.Position, error) {
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	p := obj.Position
+	return &p, nil
+}
+
+func (r *playerResolver) Orientation(ctx context.Context, obj *presence.Entity) (*presence.Orientation, error) {
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	o := obj.Orientation
+	return &o, nil
+}
+
+func (r *playerResolver) Neighbors(ctx context.Context, obj *presence.Entity, maxDistance *float64) ([]*presence.Neighbor, error) {
+	if maxDistance != nil && *maxDistance < 0 {
+		err := errors.New("graphql: maxDistance must be non-negative")
+		return nil, exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	entities, err := r.Resolver.Presence.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Players hear others within their hearing distance, except for the
+	// players that they muted.
+	s, err := r.Resolver.Settings.Get(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	dist := s.HearingDistance
+	if maxDistance != nil {
+		dist = *maxDistance
+	}
+	audible := make([]*presence.Entity, 0, len(entities))
+	for _, e := range entities {
+		if !s.HasMuted(e.ID) {
+			audible = append(audible, e)
+		}
+	}
+	entities = audible
+
+	rms, err := r.Resolver.Rooms.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := r.Resolver.Zones.Index(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rooms determine who a player can hear, and zones determine how sound
+	// travels between them.
+	entities, dist = rooms.Audible(rms, obj, entities, dist)
+	return zones.Neighbors(idx, obj, entities, dist), nil
+}
+
+func (r *playerResolver) RelativePosition(ctx context.Context, obj *presence.Entity, position presence.Position) (*presence.Position, error) {
+	if err := obj.RequirePosition(); err != nil {
+		return nil, err
+	}
+	p := position.RelativeTo(obj.Position, obj.Orientation)
+	return &p, nil
+}
+
+func (r *queryResolver) Players(ctx context.Context, space *string, dimension *minecraft.Dimension) ([]*presence.Entity, error) {
+	// dimension is a deprecated alias of space, from before players could be
+	// in spaces other than Minecraft dimensions.
+	if dimension != nil {
+		if space != nil && *space != string(*dimension) {
+			err := errors.New("graphql: space and dimension must match")
+			return nil, exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+		s := string(*dimension)
+		space = &s
+	}
+
+	entities, err := r.Resolver.Presence.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if space == nil {
+		return entities, nil
+	}
+
+	filtered := make([]*presence.Entity, 0, len(entities))
+	for _, e := range entities {
+		if err := e.RequirePosition(); err != nil {
+			return nil, err
+		}
+		if e.Space == *space {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, nil
+}
+
+func (r *queryResolver) Player(ctx context.Context, username string) (*presence.Entity, error) {
+	e, err := r.Resolver.Presence.Get(ctx, username)
+	if err != nil {
+		if errors.Is(err, presence.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *subscriptionResolver) PlayerUpdates(ctx context.Context) (<-chan *presence.Update, error) {
+	return r.Resolver.Feed.Subscribe(ctx), nil
+}
+
+// Player returns PlayerResolver implementation.
+func (r *Resolver) Player() PlayerResolver { return &playerResolver{r} }
+
+type playerResolver struct{ *Resolver }
@@ -1,14 +1,59 @@
 package graphql
 
-import "go.stevenxie.me/zoomcraft/backend/minecraft"
+import (
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/settings"
+	"go.stevenxie.me/zoomcraft/backend/zones"
+)
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 // A Resolver implements a ResolverRoot.
+//
+// The core of the schema (players and their positions) is resolved through
+// Presence and Feed, and works with any presence.Provider. Rooms and Zones
+// determine who players can hear, and how, and Settings holds the preferences
+// of each player. Teleporter moves players, and is nil if the provider cannot
+// change its world.
+//
+// Minecraft resolves the Minecraft extension of the schema, and is only set
+// with the Minecraft provider. Without it, the fields of the extension are
+// unsupported.
+//
+// Auth logs in players. If it is nil, authentication is disabled, and requests
+// need not be authenticated (see Authenticated).
 type Resolver struct {
-	Players minecraft.PlayerService
+	Presence presence.Provider
+	Feed     *presence.Feed
+	Rooms    rooms.Service
+	Zones    zones.Service
+	Settings settings.Service
+	Auth     auth.Service
+
+	Teleporter presence.Teleporter
+	Minecraft  *Minecraft
+}
+
+// Minecraft holds the services that back the Minecraft extension of the schema
+// (see schema/minecraft.graphql). Services that are unavailable with the
+// configured server (i.e. messages, for servers without RCON) are nil.
+type Minecraft struct {
+	Messages minecraft.MessageService
+	Server   minecraft.ServerService
+}
+
+// minecraft returns r.Minecraft, or an error marked as presence.ErrUnsupported
+// if the Minecraft extension of the schema is not supported.
+func (r *Resolver) minecraft(op string) (*Minecraft, error) {
+	if r.Minecraft == nil {
+		return nil, presence.UnsupportedError(op)
+	}
+	return r.Minecraft, nil
 }
 
 var _ ResolverRoot = (*Resolver)(nil)
@@ -0,0 +1,119 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+func (r *mutationResolver) CreateRoom(ctx context.Context, name string, mode *rooms.Mode, area *rooms.Area) (*rooms.Room, error) {
+	m := rooms.ModeProximity
+	if mode != nil {
+		m = *mode
+	}
+
+	// Rooms are owned by their creators, unless authentication is disabled.
+	var owner string
+	if r.Resolver.Auth != nil {
+		// Rooms with areas affect every player that enters them.
+		if area != nil {
+			if _, err := auth.RequireRole(ctx, r.Resolver.Auth, auth.RoleAdmin); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if owner, err = auth.Require(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return r.Resolver.Rooms.Create(ctx, owner, name, m, area)
+}
+
+func (r *mutationResolver) DeleteRoom(ctx context.Context, id types.ID) (bool, error) {
+	if err := r.Resolver.Rooms.Delete(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) InviteToRoom(ctx context.Context, id types.ID, username string) (*rooms.Room, error) {
+	room, err := r.Resolver.Rooms.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.Resolver.requireOwner(ctx, room.Owner); err != nil {
+		return nil, err
+	}
+	return r.Resolver.Rooms.Invite(ctx, id, username)
+}
+
+func (r *mutationResolver) JoinRoom(ctx context.Context, id types.ID, username string) (*rooms.Room, error) {
+	return r.Resolver.Rooms.Join(ctx, id, username)
+}
+
+func (r *mutationResolver) LeaveRoom(ctx context.Context, id types.ID, username string) (*rooms.Room, error) {
+	return r.Resolver.Rooms.Leave(ctx, id, username)
+}
+
+func (r *playerResolver) Room(ctx context.Context, obj *presence.Entity) (*rooms.Room, error) {
+	rms, err := r.Resolver.Rooms.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rooms.Find(rms, obj), nil
+}
+
+func (r *queryResolver) Rooms(ctx context.Context) ([]*rooms.Room, error) {
+	return r.Resolver.Rooms.List(ctx)
+}
+
+func (r *queryResolver) Room(ctx context.Context, id types.ID) (*rooms.Room, error) {
+	room, err := r.Resolver.Rooms.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, rooms.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+func (r *roomResolver) Owner(ctx context.Context, obj *rooms.Room) (*string, error) {
+	if obj.Owner == "" {
+		return nil, nil
+	}
+	return &obj.Owner, nil
+}
+
+func (r *roomResolver) Members(ctx context.Context, obj *rooms.Room) ([]*presence.Entity, error) {
+	rms, err := r.Resolver.Rooms.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := r.Resolver.Presence.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members are resolved against all rooms, since players that joined
+	// another room are not members of obj even if they are within its area.
+	members := make([]*presence.Entity, 0, len(entities))
+	for _, e := range entities {
+		if room := rooms.Find(rms, e); room != nil && room.ID == obj.ID {
+			members = append(members, e)
+		}
+	}
+	return members, nil
+}
+
+// Room returns RoomResolver implementation.
+func (r *Resolver) Room() RoomResolver { return &roomResolver{r} }
+
+type roomResolver struct{ *Resolver }
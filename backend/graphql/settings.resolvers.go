@@ -0,0 +1,29 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/settings"
+)
+
+func (r *mutationResolver) UpdateSettings(ctx context.Context, username string, input settings.Update) (*settings.Settings, error) {
+	return r.Resolver.Settings.Update(ctx, username, &input)
+}
+
+func (r *playerResolver) Settings(ctx context.Context, obj *presence.Entity) (*settings.Settings, error) {
+	if r.Resolver.Auth != nil {
+		if err := auth.RequirePlayer(ctx, obj.ID); err != nil {
+			return nil, err
+		}
+	}
+	return r.Resolver.Settings.Get(ctx, obj.ID)
+}
+
+func (r *queryResolver) Settings(ctx context.Context, username string) (*settings.Settings, error) {
+	return r.Resolver.Settings.Get(ctx, username)
+}
@@ -0,0 +1,91 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/zones"
+)
+
+func (r *mutationResolver) CreateZone(ctx context.Context, input zones.Zone) (*zones.Zone, error) {
+	return r.Resolver.Zones.Create(ctx, &input)
+}
+
+func (r *mutationResolver) UpdateZone(ctx context.Context, id types.ID, input zones.Zone) (*zones.Zone, error) {
+	return r.Resolver.Zones.Update(ctx, id, &input)
+}
+
+func (r *mutationResolver) DeleteZone(ctx context.Context, id types.ID) (bool, error) {
+	if err := r.Resolver.Zones.Delete(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *playerResolver) Zone(ctx context.Context, obj *presence.Entity) (*zones.Zone, error) {
+	idx, err := r.Resolver.Zones.Index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return idx.Locate(obj), nil
+}
+
+func (r *queryResolver) Zones(ctx context.Context, space *string) ([]*zones.Zone, error) {
+	zs, err := r.Resolver.Zones.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if space == nil {
+		return zs, nil
+	}
+
+	filtered := make([]*zones.Zone, 0, len(zs))
+	for _, z := range zs {
+		if z.Space == *space {
+			filtered = append(filtered, z)
+		}
+	}
+	return filtered, nil
+}
+
+func (r *queryResolver) Zone(ctx context.Context, id types.ID) (*zones.Zone, error) {
+	z, err := r.Resolver.Zones.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, zones.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return z, nil
+}
+
+func (r *zoneResolver) Players(ctx context.Context, obj *zones.Zone) ([]*presence.Entity, error) {
+	idx, err := r.Resolver.Zones.Index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := r.Resolver.Presence.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Players are resolved against the index, since players within zones
+	// nested in obj are not within obj itself.
+	players := make([]*presence.Entity, 0, len(entities))
+	for _, e := range entities {
+		if z := idx.Locate(e); z != nil && z.ID == obj.ID {
+			players = append(players, e)
+		}
+	}
+	return players, nil
+}
+
+// Zone returns ZoneResolver implementation.
+func (r *Resolver) Zone() ZoneResolver { return &zoneResolver{r} }
+
+type zoneResolver struct{ *Resolver }
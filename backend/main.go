@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,14 +14,26 @@ import (
 	"github.com/go-kit/kit/log/level"
 
 	graphqlhandler "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/cockroachdb/errors"
-	"github.com/gorcon/rcon"
 	"github.com/joho/godotenv"
 
+	"go.stevenxie.me/zoomcraft/backend/auth"
 	"go.stevenxie.me/zoomcraft/backend/graphql"
 	"go.stevenxie.me/zoomcraft/backend/graphql/graphqlutil"
 	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/sim"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/presence/scripted"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/settings"
+	"go.stevenxie.me/zoomcraft/backend/signaling"
+	"go.stevenxie.me/zoomcraft/backend/store"
 	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+	"go.stevenxie.me/zoomcraft/backend/zones"
 )
 
 func main() {
@@ -35,52 +50,369 @@ func main() {
 			logger = level.NewFilter(logger, level.AllowInfo())
 		}
 
-		// Create Minecraft client.
-		var client *minecraft.Client
-		if err := func() (err error) {
-			var (
-				addr = getEnv("RCON_ADDRESS", "localhost:25575")
-				pass = getEnv("RCON_PASSWORD", "minecraft")
+		// Register presence providers, which report the players in a world.
+		//
+		// Optional capabilities are created by the providers that support
+		// them, and are left nil otherwise. The Minecraft provider also
+		// registers the Minecraft extension of the GraphQL schema.
+		var (
+			verifier   auth.Verifier
+			teleporter presence.Teleporter
+			mc         *graphql.Minecraft
+			health     http.Handler // reports the state of the RCON client
+			interval   = "100ms"
+
+			// validateUsername checks that usernames follow the rules of the
+			// game, if it has any.
+			validateUsername func(username string) error
+		)
+		registry := presence.NewRegistry()
+		registry.Register("minecraft", func() (presence.Provider, error) {
+			validateUsername = command.ValidateUsername
+
+			// Select how to read from the Minecraft server: through RCON, or
+			// through its status (for hosts that do not expose RCON).
+			switch source := getEnv("BACKEND_PLAYER_SOURCE", "rcon"); source {
+			case "rcon":
+				var (
+					addr = getEnv("RCON_ADDRESS", "localhost:25575")
+					pass = getEnv("RCON_PASSWORD", "minecraft")
+				)
+				poolSize, err := strconv.Atoi(getEnv("RCON_POOL_SIZE", "4"))
+				if err != nil {
+					return nil, errors.Wrap(err, "parse pool size")
+				}
+
+				// Serve a simulated world over RCON, in place of a real
+				// server.
+				simulate := isTruthy(os.Getenv("BACKEND_SIMULATE"))
+				if simulate {
+					n, err := strconv.Atoi(getEnv("SIMULATED_PLAYERS", "3"))
+					if err != nil {
+						return nil, errors.Wrap(err, "parse number of simulated players")
+					}
+					lis, err := net.Listen("tcp", "localhost:0")
+					if err != nil {
+						return nil, errors.Wrap(err, "listen for simulated server")
+					}
+					simulator := sim.NewServer(
+						sim.New(sim.Demo(n)), pass,
+						logutil.WithComponent(logger, "sim"),
+					)
+					go simulator.Serve(context.Background(), lis)
+					addr = lis.Addr().String()
+				}
+
+				client := minecraft.NewClient(
+					addr, pass,
+					func(cfg *minecraft.ClientConfig) {
+						cfg.Logger = logutil.WithComponent(logger, "client")
+						cfg.PoolSize = poolSize
+					},
+				)
+
+				// The client reconnects on demand, so the server need not be
+				// reachable yet.
+				if err := client.Connect(); err != nil {
+					l := logutil.WithError(logger, err)
+					l = level.Warn(l)
+					logutil.Log(l, "failed to connect with RCON")
+				}
+				health = minecraft.ServeHealth(client)
+
+				origin := minecraft.NewPlayerService(
+					client,
+					logutil.WithComponent(logger, "player_service"),
+				)
+
+				// Teleports read back players from the origin, since the
+				// watcher's snapshot may not reflect them yet.
+				teleporter = minecraft.NewTeleporter(
+					minecraft.NewTeleportService(
+						client, origin,
+						logutil.WithComponent(logger, "teleport_service"),
+					),
+				)
+				mc = &graphql.Minecraft{
+					Messages: minecraft.NewMessageService(
+						client,
+						logutil.WithComponent(logger, "message_service"),
+					),
+					Server: minecraft.NewServerService(
+						client,
+						logutil.WithComponent(logger, "server_service"),
+					),
+				}
+
+				// Simulated players cannot enter login codes.
+				if !simulate {
+					verifier = minecraft.NewVerifier(
+						client,
+						logutil.WithComponent(logger, "verifier"),
+					)
+				}
+				return minecraft.NewProvider(origin), nil
+			case "status":
+				var (
+					addr = getEnv("STATUS_ADDRESS", "localhost:25565")
+					opt  = func(cfg *minecraft.StatusConfig) {
+						cfg.QueryAddress = os.Getenv("QUERY_ADDRESS")
+					}
+				)
+				origin := minecraft.NewStatusPlayerService(
+					addr,
+					logutil.WithComponent(logger, "player_service"),
+					opt,
+				)
+				mc = &graphql.Minecraft{
+					Server: minecraft.NewStatusServerService(
+						addr,
+						logutil.WithComponent(logger, "server_service"),
+						opt,
+					),
+				}
+
+				// Status requests are relatively expensive, and the status
+				// only changes when players join or leave.
+				interval = "1s"
+				return minecraft.NewProvider(origin), nil
+			default:
+				return nil, errors.Newf("unknown player source '%s'", source)
+			}
+		})
+		registry.Register("scripted", func() (presence.Provider, error) {
+			n, err := strconv.Atoi(getEnv("SCRIPTED_ACTORS", "3"))
+			if err != nil {
+				return nil, errors.Wrap(err, "parse number of actors")
+			}
+			return scripted.New(scripted.Demo(n)), nil
+		})
+
+		// Create watcher for the configured provider.
+		var watcher *presence.Watcher
+		if err := func() error {
+			provider, err := registry.Open(getEnv("BACKEND_PROVIDER", "minecraft"))
+			if err != nil {
+				return err
+			}
+
+			// Serve players from a snapshot of the world that is refreshed in
+			// the background, so that requests never wait on the provider.
+			d, err := time.ParseDuration(
+				getEnv("BACKEND_POLL_INTERVAL", interval),
 			)
-			conn, err := rcon.Dial(addr, pass)
 			if err != nil {
-				return errors.Wrap(err, "dial server")
+				return errors.Wrap(err, "parse poll interval")
 			}
-			client = minecraft.NewClient(conn)
+			watcher = presence.NewWatcher(
+				provider, d,
+				logutil.WithComponent(logger, "watcher"),
+			)
 			return nil
 		}(); err != nil {
-			return errors.Wrap(err, "connect with RCON")
+			return errors.Wrap(err, "create services")
 		}
+		go watcher.Run(context.Background())
 
-		// Create services.
-		var players minecraft.PlayerService
-		if err := func() (err error) {
-			logger := logutil.WithComponent(logger, "player_service")
-			players = minecraft.NewPlayerService(client, logger)
+		// Create feed, which broadcasts player updates to subscribers.
+		feed := presence.NewFeed(watcher)
 
-			// Apply a cache layer to limit requests.
-			cache := minecraft.PlayerServiceCache{MaxAge: 100 * time.Millisecond}
-			players = cache.Apply(players)
-			return nil
+		// Open store, which persists rooms, zones, and player settings across
+		// restarts.
+		var db store.Store
+		if err := func() (err error) {
+			switch kind := getEnv("BACKEND_STORE", "file"); kind {
+			case "file":
+				// An empty path keeps the store in memory.
+				db, err = store.NewFileStore(getEnv("STORE_PATH", "store.json"))
+			case "mongo":
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				db, err = store.NewMongoStore(
+					ctx,
+					getEnv("MONGO_URI", "mongodb://localhost:27017"),
+					getEnv("MONGO_DATABASE", "zoomcraft"),
+				)
+			default:
+				err = errors.Newf("unknown store '%s'", kind)
+			}
+			return err
 		}(); err != nil {
-			return errors.Wrap(err, "create player service")
+			return errors.Wrap(err, "open store")
 		}
+		defer db.Close(context.Background())
+
+		// Create room service, which groups players into voice rooms.
+		roomService, err := rooms.NewService(
+			context.Background(),
+			db.Collection("rooms"),
+			logutil.WithComponent(logger, "room_service"),
+			func(cfg *rooms.Config) {
+				if validateUsername != nil {
+					cfg.ValidateUsername = validateUsername
+				}
+			},
+		)
+		if err != nil {
+			return errors.Wrap(err, "create room service")
+		}
+
+		// Create zone service, which manages the zones that limit how sound
+		// travels.
+		zoneService, err := zones.NewService(
+			context.Background(),
+			db.Collection("zones"),
+			logutil.WithComponent(logger, "zone_service"),
+		)
+		if err != nil {
+			return errors.Wrap(err, "create zone service")
+		}
+
+		// Create settings service, which manages the preferences of each
+		// player.
+		settingsService, err := settings.NewService(
+			context.Background(),
+			db.Collection("settings"),
+			logutil.WithComponent(logger, "settings_service"),
+			func(cfg *settings.Config) {
+				if validateUsername != nil {
+					cfg.ValidateUsername = validateUsername
+				}
+			},
+		)
+		if err != nil {
+			return errors.Wrap(err, "create settings service")
+		}
+
+		// Create auth service, which links browser sessions to the accounts
+		// of players.
+		//
+		// It requires a way to verify players, so it is disabled by default
+		// (i.e. for development) with providers that lack one.
+		var authService auth.Service
+		enableAuth := verifier != nil
+		if v, ok := os.LookupEnv("BACKEND_AUTH"); ok {
+			enableAuth = isTruthy(v)
+		}
+		if enableAuth {
+			if verifier == nil {
+				return errors.New(
+					"authentication is unsupported with this provider " +
+						"(set BACKEND_AUTH=false to disable it)",
+				)
+			}
+
+			// Sign session tokens with AUTH_SECRET, or a secret kept in the
+			// store.
+			secret := []byte(os.Getenv("AUTH_SECRET"))
+			if len(secret) == 0 {
+				if secret, err = auth.LoadSecret(
+					context.Background(),
+					db.Collection("auth"),
+				); err != nil {
+					return errors.Wrap(err, "load auth secret")
+				}
+			}
+
+			// Only admins (from BACKEND_ADMINS, a comma-separated list of
+			// usernames) can manage rooms and zones, and message players.
+			var admins []string
+			for _, name := range strings.Split(os.Getenv("BACKEND_ADMINS"), ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					admins = append(admins, name)
+				}
+			}
+			if len(admins) == 0 {
+				l := level.Warn(logger)
+				logutil.Log(l, "no admins are configured (set BACKEND_ADMINS)")
+			}
+			authService = auth.NewService(
+				verifier, secret,
+				logutil.WithComponent(logger, "auth_service"),
+				func(cfg *auth.Config) { cfg.Admins = admins },
+			)
+		} else {
+			l := level.Warn(logger)
+			if verifier == nil {
+				l = log.With(l, "reason", "unsupported by provider")
+			}
+			logutil.Log(l, "authentication is disabled")
+		}
+
+		// Create signaling hub, which relays WebRTC connection information
+		// between the clients of online players.
+		hub := signaling.NewHub(
+			watcher,
+			logutil.WithComponent(logger, "signaling"),
+			func(cfg *signaling.HubConfig) { cfg.Auth = authService },
+		)
 
 		// Create executable schema.
+		resolver := &graphql.Resolver{
+			Presence:   watcher,
+			Feed:       feed,
+			Rooms:      roomService,
+			Zones:      zoneService,
+			Settings:   settingsService,
+			Auth:       authService,
+			Teleporter: teleporter,
+			Minecraft:  mc,
+		}
 		schema := graphql.NewExecutableSchema(graphql.Config{
-			Resolvers: &graphql.Resolver{
-				Players: players,
+			Resolvers: resolver,
+			Directives: graphql.DirectiveRoot{
+				Authenticated: resolver.Authenticated,
 			},
 		})
 
-		// Create and configure handler.
-		handler := graphqlhandler.NewDefaultServer(schema)
+		// Create and configure handler, with the same transports and
+		// extensions as the default server.
+		//
+		// Subscriptions are served over websockets, whose clients
+		// authenticate through the payloads of their init messages (since
+		// browsers cannot set headers on websocket requests).
+		handler := graphqlhandler.New(schema)
+		handler.AddTransport(transport.Websocket{
+			KeepAlivePingInterval: 10 * time.Second,
+			InitFunc: func(
+				ctx context.Context,
+				payload transport.InitPayload,
+			) (context.Context, error) {
+				if authService == nil {
+					return ctx, nil
+				}
+				return auth.Authorize(ctx, authService, payload.Authorization())
+			},
+		})
+		handler.AddTransport(transport.Options{})
+		handler.AddTransport(transport.GET{})
+		handler.AddTransport(transport.POST{})
+		handler.AddTransport(transport.MultipartForm{})
+		handler.SetQueryCache(lru.New(1000))
+		handler.Use(extension.Introspection{})
+		handler.Use(extension.AutomaticPersistedQuery{Cache: lru.New(100)})
 		handler.SetErrorPresenter(graphqlutil.PresentError)
 
 		// Register HTTP routes.
 		mux := http.NewServeMux()
-		mux.Handle("/graphql", handler)
+		if authService != nil {
+			// Behind the gateway, requesters are identified by the
+			// X-Forwarded-For header that it sets (see BACKEND_TRUST_PROXY).
+			trustProxy := isTruthy(os.Getenv("BACKEND_TRUST_PROXY"))
+			mux.Handle("/graphql", auth.Middleware(
+				authService,
+				logutil.WithComponent(logger, "auth"),
+				handler,
+				func(cfg *auth.MiddlewareConfig) { cfg.TrustForwarded = trustProxy },
+			))
+		} else {
+			mux.Handle("/graphql", handler)
+		}
 		mux.Handle("/graphiql", graphqlutil.ServeGraphiQL("./graphql"))
+		mux.Handle("/signaling", hub)
+		if health != nil {
+			mux.Handle("/health", health)
+		}
 
 		// Create and run server.
 		port := getEnv("BACKEND_PORT", "9090")
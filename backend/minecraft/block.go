@@ -0,0 +1,338 @@
+package minecraft
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/util/vecutil"
+)
+
+// Chunks are 16 blocks wide, and regions are 32 chunks wide.
+const (
+	chunkShift  = 4
+	regionShift = 5
+)
+
+// A BlockPosition is the integer position of a block in the Minecraft world.
+type BlockPosition struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	Z int `json:"z"`
+}
+
+// A ChunkPosition is the position of a (16×16 block) chunk in the Minecraft
+// world.
+type ChunkPosition struct {
+	X int `json:"x"`
+	Z int `json:"z"`
+}
+
+// A RegionPosition is the position of a (32×32 chunk) region in the Minecraft
+// world, as used in the names of region files.
+type RegionPosition struct {
+	X int `json:"x"`
+	Z int `json:"z"`
+}
+
+// Block returns the position of the block containing c.
+//
+// Coordinates are floored rather than truncated, so that i.e. an X of -0.5
+// lies in the block at X = -1.
+func (c Coordinates) Block() BlockPosition {
+	return BlockPosition{
+		X: int(math.Floor(c.X)),
+		Y: int(math.Floor(c.Y)),
+		Z: int(math.Floor(c.Z)),
+	}
+}
+
+// Chunk returns the position of the chunk containing c.
+func (c Coordinates) Chunk() ChunkPosition { return c.Block().Chunk() }
+
+// Region returns the position of the region containing c.
+func (c Coordinates) Region() RegionPosition { return c.Block().Chunk().Region() }
+
+// Chunk returns the position of the chunk containing b.
+func (b BlockPosition) Chunk() ChunkPosition {
+	return ChunkPosition{X: b.X >> chunkShift, Z: b.Z >> chunkShift}
+}
+
+// Region returns the position of the region containing c.
+func (c ChunkPosition) Region() RegionPosition {
+	return RegionPosition{X: c.X >> regionShift, Z: c.Z >> regionShift}
+}
+
+// Coordinates returns the coordinates of the minimum corner of b.
+func (b BlockPosition) Coordinates() Coordinates {
+	return Coordinates{X: float64(b.X), Y: float64(b.Y), Z: float64(b.Z)}
+}
+
+// ParseBlockPosition parses a BlockPosition from a string, in either list
+// syntax (i.e. "[1, 64, -3]") or command syntax (i.e. "1 64 -3").
+func ParseBlockPosition(s string) (BlockPosition, error) {
+	parts, err := parseIntComponents(s, blockComponents)
+	if err != nil {
+		return BlockPosition{}, err
+	}
+	return BlockPosition{X: parts[0], Y: parts[1], Z: parts[2]}, nil
+}
+
+// ParseChunkPosition parses a ChunkPosition from a string, in either list
+// syntax (i.e. "[1, -3]") or command syntax (i.e. "1 -3").
+func ParseChunkPosition(s string) (ChunkPosition, error) {
+	parts, err := parseIntComponents(s, planarComponents)
+	if err != nil {
+		return ChunkPosition{}, err
+	}
+	return ChunkPosition{X: parts[0], Z: parts[1]}, nil
+}
+
+// ParseRegionPosition parses a RegionPosition from a string, in either list
+// syntax (i.e. "[1, -3]") or command syntax (i.e. "1 -3").
+func ParseRegionPosition(s string) (RegionPosition, error) {
+	parts, err := parseIntComponents(s, planarComponents)
+	if err != nil {
+		return RegionPosition{}, err
+	}
+	return RegionPosition{X: parts[0], Z: parts[1]}, nil
+}
+
+var (
+	blockComponents  = []string{"x", "y", "z"}
+	planarComponents = []string{"x", "z"}
+)
+
+func (b BlockPosition) components() []int  { return []int{b.X, b.Y, b.Z} }
+func (c ChunkPosition) components() []int  { return []int{c.X, c.Z} }
+func (r RegionPosition) components() []int { return []int{r.X, r.Z} }
+
+var (
+	_ fmt.Stringer = (*BlockPosition)(nil)
+	_ fmt.Stringer = (*ChunkPosition)(nil)
+	_ fmt.Stringer = (*RegionPosition)(nil)
+)
+
+func (b BlockPosition) String() string {
+	return "[" + formatIntComponents(b.components(), ", ") + "]"
+}
+
+func (c ChunkPosition) String() string {
+	return "[" + formatIntComponents(c.components(), ", ") + "]"
+}
+
+func (r RegionPosition) String() string {
+	return "[" + formatIntComponents(r.components(), ", ") + "]"
+}
+
+var (
+	_ graphql.Marshaler   = (*BlockPosition)(nil)
+	_ graphql.Unmarshaler = (*BlockPosition)(nil)
+	_ graphql.Marshaler   = (*ChunkPosition)(nil)
+	_ graphql.Unmarshaler = (*ChunkPosition)(nil)
+	_ graphql.Marshaler   = (*RegionPosition)(nil)
+	_ graphql.Unmarshaler = (*RegionPosition)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (b BlockPosition) MarshalGQL(w io.Writer) { writeIntList(w, b.components()) }
+
+// MarshalGQL implements graphql.Marshaler.
+func (c ChunkPosition) MarshalGQL(w io.Writer) { writeIntList(w, c.components()) }
+
+// MarshalGQL implements graphql.Marshaler.
+func (r RegionPosition) MarshalGQL(w io.Writer) { writeIntList(w, r.components()) }
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (b *BlockPosition) UnmarshalGQL(v interface{}) error {
+	parts, err := decodeIntComponentsGQL(v, blockComponents, "BlockPosition")
+	if err != nil {
+		return err
+	}
+	*b = BlockPosition{X: parts[0], Y: parts[1], Z: parts[2]}
+	return nil
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (c *ChunkPosition) UnmarshalGQL(v interface{}) error {
+	parts, err := decodeIntComponentsGQL(v, planarComponents, "ChunkPosition")
+	if err != nil {
+		return err
+	}
+	*c = ChunkPosition{X: parts[0], Z: parts[1]}
+	return nil
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (r *RegionPosition) UnmarshalGQL(v interface{}) error {
+	parts, err := decodeIntComponentsGQL(v, planarComponents, "RegionPosition")
+	if err != nil {
+		return err
+	}
+	*r = RegionPosition{X: parts[0], Z: parts[1]}
+	return nil
+}
+
+var (
+	_ json.Marshaler   = (*BlockPosition)(nil)
+	_ json.Unmarshaler = (*BlockPosition)(nil)
+	_ json.Marshaler   = (*ChunkPosition)(nil)
+	_ json.Unmarshaler = (*ChunkPosition)(nil)
+	_ json.Marshaler   = (*RegionPosition)(nil)
+	_ json.Unmarshaler = (*RegionPosition)(nil)
+)
+
+// MarshalJSON implements json.Marshaler.
+func (b BlockPosition) MarshalJSON() ([]byte, error) {
+	return []byte("[" + formatIntComponents(b.components(), ",") + "]"), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c ChunkPosition) MarshalJSON() ([]byte, error) {
+	return []byte("[" + formatIntComponents(c.components(), ",") + "]"), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r RegionPosition) MarshalJSON() ([]byte, error) {
+	return []byte("[" + formatIntComponents(r.components(), ",") + "]"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockPosition) UnmarshalJSON(data []byte) error {
+	parts, err := unmarshalIntComponents(data, blockComponents)
+	if err != nil {
+		return errors.Wrap(err, "minecraft: unmarshal BlockPosition")
+	}
+	*b = BlockPosition{X: parts[0], Y: parts[1], Z: parts[2]}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChunkPosition) UnmarshalJSON(data []byte) error {
+	parts, err := unmarshalIntComponents(data, planarComponents)
+	if err != nil {
+		return errors.Wrap(err, "minecraft: unmarshal ChunkPosition")
+	}
+	*c = ChunkPosition{X: parts[0], Z: parts[1]}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RegionPosition) UnmarshalJSON(data []byte) error {
+	parts, err := unmarshalIntComponents(data, planarComponents)
+	if err != nil {
+		return errors.Wrap(err, "minecraft: unmarshal RegionPosition")
+	}
+	*r = RegionPosition{X: parts[0], Z: parts[1]}
+	return nil
+}
+
+var (
+	_ encoding.TextMarshaler   = (*BlockPosition)(nil)
+	_ encoding.TextUnmarshaler = (*BlockPosition)(nil)
+	_ encoding.TextMarshaler   = (*ChunkPosition)(nil)
+	_ encoding.TextUnmarshaler = (*ChunkPosition)(nil)
+	_ encoding.TextMarshaler   = (*RegionPosition)(nil)
+	_ encoding.TextUnmarshaler = (*RegionPosition)(nil)
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (b BlockPosition) MarshalText() ([]byte, error) {
+	return []byte(formatIntComponents(b.components(), " ")), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c ChunkPosition) MarshalText() ([]byte, error) {
+	return []byte(formatIntComponents(c.components(), " ")), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r RegionPosition) MarshalText() ([]byte, error) {
+	return []byte(formatIntComponents(r.components(), " ")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *BlockPosition) UnmarshalText(text []byte) (err error) {
+	*b, err = ParseBlockPosition(string(text))
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *ChunkPosition) UnmarshalText(text []byte) (err error) {
+	*c, err = ParseChunkPosition(string(text))
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *RegionPosition) UnmarshalText(text []byte) (err error) {
+	*r, err = ParseRegionPosition(string(text))
+	return err
+}
+
+// toInts converts parts to integers, failing if any of them are fractional.
+func toInts(parts []float64) ([]int, error) {
+	ints := make([]int, len(parts))
+	for i, p := range parts {
+		if p != math.Trunc(p) || math.Abs(p) > math.MaxInt32 {
+			return nil, errors.Newf("minecraft: part %d is not an integer", i)
+		}
+		ints[i] = int(p)
+	}
+	return ints, nil
+}
+
+func parseIntComponents(s string, names []string) ([]int, error) {
+	parts, err := vecutil.ParseComponents(s, names, 64)
+	if err != nil {
+		return nil, err
+	}
+	return toInts(parts)
+}
+
+func unmarshalIntComponents(data []byte, names []string) ([]int, error) {
+	parts, err := vecutil.UnmarshalComponents(data, names, 64)
+	if err != nil {
+		return nil, err
+	}
+	return toInts(parts)
+}
+
+func decodeIntComponentsGQL(
+	v interface{},
+	names []string,
+	typeName string,
+) (ints []int, err error) {
+	defer func() {
+		if err != nil {
+			err = errors.WithDetailf(err, "Failed to parse %s.", typeName)
+			err = exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+	}()
+
+	parts, err := vecutil.DecodeComponents(v, names, 64)
+	if err != nil {
+		return nil, err
+	}
+	return toInts(parts)
+}
+
+func formatIntComponents(parts []int, sep string) string {
+	strs := make([]string, len(parts))
+	for i, p := range parts {
+		strs[i] = strconv.Itoa(p)
+	}
+	return strings.Join(strs, sep)
+}
+
+func writeIntList(w io.Writer, parts []int) {
+	io.WriteString(w, "["+formatIntComponents(parts, ",")+"]")
+}
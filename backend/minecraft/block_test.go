@@ -0,0 +1,130 @@
+package minecraft
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCoordinatesBlock(t *testing.T) {
+	tests := []struct {
+		c      Coordinates
+		block  BlockPosition
+		chunk  ChunkPosition
+		region RegionPosition
+	}{
+		{
+			c:     Coordinates{X: 0, Y: 64, Z: 0},
+			block: BlockPosition{X: 0, Y: 64, Z: 0},
+		},
+		{
+			c:     Coordinates{X: 0.5, Y: 64.9, Z: 15.99},
+			block: BlockPosition{X: 0, Y: 64, Z: 15},
+		},
+		{
+			c:      Coordinates{X: -0.5, Y: -0.5, Z: -0.5},
+			block:  BlockPosition{X: -1, Y: -1, Z: -1},
+			chunk:  ChunkPosition{X: -1, Z: -1},
+			region: RegionPosition{X: -1, Z: -1},
+		},
+		{
+			c:     Coordinates{X: 16, Y: 0, Z: 31.5},
+			block: BlockPosition{X: 16, Y: 0, Z: 31},
+			chunk: ChunkPosition{X: 1, Z: 1},
+		},
+		{
+			c:      Coordinates{X: -16, Y: 0, Z: -16},
+			block:  BlockPosition{X: -16, Y: 0, Z: -16},
+			chunk:  ChunkPosition{X: -1, Z: -1},
+			region: RegionPosition{X: -1, Z: -1},
+		},
+		{
+			c:      Coordinates{X: -17, Y: 0, Z: -16.5},
+			block:  BlockPosition{X: -17, Y: 0, Z: -17},
+			chunk:  ChunkPosition{X: -2, Z: -2},
+			region: RegionPosition{X: -1, Z: -1},
+		},
+		{
+			c:      Coordinates{X: 511.9, Y: 0, Z: 512},
+			block:  BlockPosition{X: 511, Y: 0, Z: 512},
+			chunk:  ChunkPosition{X: 31, Z: 32},
+			region: RegionPosition{X: 0, Z: 1},
+		},
+		{
+			c:      Coordinates{X: -512, Y: 0, Z: -512.5},
+			block:  BlockPosition{X: -512, Y: 0, Z: -513},
+			chunk:  ChunkPosition{X: -32, Z: -33},
+			region: RegionPosition{X: -1, Z: -2},
+		},
+	}
+	for _, test := range tests {
+		if got := test.c.Block(); got != test.block {
+			t.Errorf("%v: expected block %v, got %v", test.c, test.block, got)
+		}
+		if got := test.c.Chunk(); got != test.chunk {
+			t.Errorf("%v: expected chunk %v, got %v", test.c, test.chunk, got)
+		}
+		if got := test.c.Region(); got != test.region {
+			t.Errorf("%v: expected region %v, got %v", test.c, test.region, got)
+		}
+		if got := test.block.Coordinates().Block(); got != test.block {
+			t.Errorf("%v: expected corner to lie in the block, got %v", test.block, got)
+		}
+	}
+}
+
+func TestParseBlockPosition(t *testing.T) {
+	for s, want := range map[string]BlockPosition{
+		"1 64 -3":      {X: 1, Y: 64, Z: -3},
+		"[1, 64, -3]":  {X: 1, Y: 64, Z: -3},
+		" -16 0 -17 ":  {X: -16, Y: 0, Z: -17},
+		"1.0 64.0 -3":  {X: 1, Y: 64, Z: -3},
+		"[-0, 0, 100]": {X: 0, Y: 0, Z: 100},
+	} {
+		got, err := ParseBlockPosition(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: expected %v, got %v", s, want, got)
+		}
+	}
+	for _, s := range []string{
+		"1.5 64 -3",
+		"1 64",
+		"~ ~1 ~",
+		"1 64 1e10",
+		"NaN 64 0",
+	} {
+		if _, err := ParseBlockPosition(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
+
+func TestBlockPositionJSON(t *testing.T) {
+	for _, v := range []interface{}{
+		&BlockPosition{X: -17, Y: 64, Z: 16},
+		&ChunkPosition{X: -2, Z: 1},
+		&RegionPosition{X: -1, Z: 0},
+	} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal %v: %v", v, err)
+		}
+		got := reflect.New(reflect.TypeOf(v).Elem()).Interface()
+		if err = json.Unmarshal(data, got); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("expected %v to round-trip through %s, got %v", v, data, got)
+		}
+	}
+
+	// Fractional positions are rejected, rather than floored.
+	var b BlockPosition
+	if err := json.Unmarshal([]byte(`{"x": -0.5, "y": 0, "z": 0}`), &b); err == nil {
+		t.Error("expected a fractional BlockPosition to be rejected")
+	}
+}
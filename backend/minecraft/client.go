@@ -1,28 +1,306 @@
 package minecraft
 
 import (
+	stderrors "errors"
+	"net"
+	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
 	"github.com/gorcon/rcon"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
 )
 
 // A Client is used to communicate with a Minecraft server.
+//
+// It owns a bounded pool of connections to the server, and transparently
+// redials them whenever they break. Failed dials are followed by exponentially
+// increasing backoff periods, during which commands fail immediately.
 type Client struct {
-	conn *rcon.Conn
-	mux  sync.Mutex
+	addr     string
+	password string
+	cfg      ClientConfig
+	logger   log.Logger
+
+	// pool holds idle connection slots; a slot with a nil conn has yet to be
+	// (re)dialed.
+	pool  chan *slot
+	state int32
+
+	mu       sync.Mutex
+	failures int       // the number of consecutive failed dial attempts
+	retryAt  time.Time // when the server may next be dialed
+}
+
+type slot struct{ conn *rcon.Conn }
+
+// ClientConfig configures a Client.
+type ClientConfig struct {
+	Logger log.Logger
+
+	// MinBackoff and MaxBackoff bound the delay between reconnection attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// MaxAttempts is the number of consecutive failed dial attempts after
+	// which a Client enters StateFailed. It continues to redial the server
+	// (every MaxBackoff) thereafter.
+	MaxAttempts int
+
+	// PoolSize is the maximum number of connections a Client opens to the
+	// server, and thus the number of commands it executes concurrently.
+	//
+	// A PoolSize of 1 serializes all commands over a single connection.
+	PoolSize int
+}
+
+// NewClient creates a new Client that connects to the RCON server at addr.
+//
+// The connection is established lazily; use Connect to dial eagerly.
+func NewClient(addr, password string, opts ...func(*ClientConfig)) *Client {
+	cfg := ClientConfig{
+		Logger:      log.NewNopLogger(),
+		MinBackoff:  250 * time.Millisecond,
+		MaxBackoff:  8 * time.Second,
+		MaxAttempts: 6,
+		PoolSize:    1,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.PoolSize < 1 {
+		cfg.PoolSize = 1
+	}
+
+	pool := make(chan *slot, cfg.PoolSize)
+	for i := 0; i < cfg.PoolSize; i++ {
+		pool <- new(slot)
+	}
+	return &Client{
+		addr:     addr,
+		password: password,
+		cfg:      cfg,
+		logger:   level.NewInjector(cfg.Logger, level.DebugValue()),
+		pool:     pool,
+		state:    int32(StateReconnecting),
+	}
+}
+
+// PoolSize returns the maximum number of connections used by the Client.
+func (c *Client) PoolSize() int { return c.cfg.PoolSize }
+
+// Connect dials the server, if the Client is not already connected.
+//
+// Unlike Execute, Connect waits out backoff periods, until it either connects
+// or the Client enters StateFailed. Only a single connection is dialed; the
+// rest of the pool is filled lazily.
+func (c *Client) Connect() error {
+	for {
+		err := func() error {
+			s := <-c.pool
+			defer func() { c.pool <- s }()
+			if s.conn != nil {
+				return nil
+			}
+			return c.redial(s)
+		}()
+		if err == nil || c.State() == StateFailed {
+			return err
+		}
+		time.Sleep(c.backoff())
+	}
 }
 
 // Execute executes a command on a Minecraft server, and returns the resulting
 // output.
 //
-// It rate-limits and buffers requests to prevent overloading.
-func (c *Client) Execute(command string) (out string, err error) {
-	c.mux.Lock()
-	defer c.mux.Unlock()
-	return c.conn.Execute(command)
+// It blocks until a connection from the pool is available. If the connection
+// is broken before the command is sent, Execute redials the server and
+// retries the command once. Commands are never retried once they may have
+// reached the server, since many (i.e. tp and tellraw) are not idempotent;
+// instead, the connection is dropped and redialed by the next command. While
+// the Client is backing off from a failed dial, Execute fails immediately with
+// ErrUnavailable.
+func (c *Client) Execute(cmd command.Command) (out string, err error) {
+	if cmd.IsZero() {
+		return "", errors.New("minecraft: empty command")
+	}
+
+	s := <-c.pool
+	defer func() { c.pool <- s }()
+
+	if s.conn == nil {
+		if err = c.redial(s); err != nil {
+			return "", err
+		}
+	}
+	out, err = s.conn.Execute(cmd.String())
+	if !isConnError(err) {
+		return out, err
+	}
+	logutil.Log(
+		logutil.WithError(c.logger, err),
+		"connection broken, reconnecting",
+	)
+	s.drop()
+	c.setState(StateReconnecting)
+	if !isWriteError(err) {
+		err = errors.Wrap(err, "minecraft: read response")
+		return "", unavailable(err)
+	}
+	if err = c.redial(s); err != nil {
+		return "", err
+	}
+	return s.conn.Execute(cmd.String())
+}
+
+// State returns the current ConnState of the Client.
+func (c *Client) State() ConnState {
+	return ConnState(atomic.LoadInt32(&c.state))
 }
 
-// NewClient creates a new Client.
-func NewClient(conn *rcon.Conn) *Client {
-	return &Client{conn: conn}
+// Close closes the Client's connections to the server, waiting for any
+// in-flight commands to complete.
+func (c *Client) Close() error {
+	slots := make([]*slot, 0, c.cfg.PoolSize)
+	defer func() {
+		for _, s := range slots {
+			c.pool <- s
+		}
+	}()
+
+	var err error
+	for i := 0; i < c.cfg.PoolSize; i++ {
+		s := <-c.pool
+		if s.conn != nil {
+			if cerr := s.conn.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+			s.conn = nil
+		}
+		slots = append(slots, s)
+	}
+	c.setState(StateReconnecting)
+	return err
 }
+
+// redial makes a single attempt to dial the server into s, unless the Client
+// is backing off from a failed attempt.
+//
+// Backoff periods are enforced by failing fast rather than sleeping, so that
+// callers do not hold on to s (and block other callers) while the server is
+// down.
+//
+// s must be checked out of the pool by the caller.
+func (c *Client) redial(s *slot) error {
+	if wait := c.backoff(); wait > 0 {
+		err := errors.Newf("minecraft: backing off for %v", wait)
+		return unavailable(err)
+	}
+
+	conn, err := rcon.Dial(c.addr, c.password)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		s.conn = conn
+		c.failures = 0
+		c.retryAt = time.Time{}
+		c.setState(StateConnected)
+		logutil.Log(c.logger, "connected to server")
+		return nil
+	}
+
+	c.failures++
+	backoff := c.cfg.MinBackoff
+	for i := 1; i < c.failures && backoff < c.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.cfg.MaxBackoff {
+		backoff = c.cfg.MaxBackoff
+	}
+	c.retryAt = time.Now().Add(backoff)
+
+	// Retrying will not help if the password is wrong.
+	if c.failures >= c.cfg.MaxAttempts || errors.Is(err, rcon.ErrAuthFailed) {
+		c.setState(StateFailed)
+	} else {
+		c.setState(StateReconnecting)
+	}
+	{
+		l := log.With(c.logger, "attempt", c.failures, "backoff", backoff)
+		logutil.Log(logutil.WithError(l, err), "failed to dial server")
+	}
+	return unavailable(errors.Wrap(err, "dial server"))
+}
+
+// backoff returns the time remaining until the server may next be dialed.
+func (c *Client) backoff() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Until(c.retryAt)
+}
+
+func unavailable(err error) error {
+	err = errors.Mark(err, ErrUnavailable)
+	return exthttp.WrapWithHTTPCode(err, http.StatusServiceUnavailable)
+}
+
+// drop discards the slot's connection.
+func (s *slot) drop() {
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+}
+
+func (c *Client) setState(s ConnState) { atomic.StoreInt32(&c.state, int32(s)) }
+
+// isConnError reports whether err leaves an rcon.Conn unusable.
+//
+// Apart from oversized commands (which are rejected before anything is sent),
+// any failure may have left the stream in an unknown state.
+func isConnError(err error) bool {
+	return (err != nil) && !errors.Is(err, rcon.ErrCommandTooLong)
+}
+
+// isWriteError reports whether err occurred while sending a command, in which
+// case the server cannot have executed it (since it only executes complete
+// packets).
+func isWriteError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "write"
+}
+
+// A ConnState describes the state of a Client's connection.
+type ConnState int32
+
+// The set of valid ConnStates.
+const (
+	StateConnected ConnState = iota
+	StateReconnecting
+	StateFailed
+)
+
+func (s ConnState) String() string {
+	switch s {
+	case StateConnected:
+		return "connected"
+	case StateReconnecting:
+		return "reconnecting"
+	case StateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrUnavailable is returned when a Client is unable to reach the server.
+var ErrUnavailable = stderrors.New("minecraft: server unavailable")
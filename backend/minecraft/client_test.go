@@ -0,0 +1,250 @@
+package minecraft
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+)
+
+func echo(cmd string) string { return cmd }
+
+func TestClientReconnects(t *testing.T) {
+	srv := newStubServer(t, echo)
+	c := NewClient(srv.Addr(), stubPassword, func(cfg *ClientConfig) {
+		cfg.MinBackoff = 50 * time.Millisecond
+		cfg.MaxBackoff = 100 * time.Millisecond
+		cfg.MaxAttempts = 3
+	})
+	defer c.Close()
+
+	expectState := func(want ConnState) {
+		t.Helper()
+		if got := c.State(); got != want {
+			t.Fatalf("expected state %s, got %s", want, got)
+		}
+	}
+	execute := func() error {
+		t.Helper()
+		out, err := c.Execute(command.List())
+		if err == nil && out != "list" {
+			t.Fatalf("unexpected output %q", out)
+		}
+		return err
+	}
+
+	expectState(StateReconnecting)
+	if err := c.Connect(); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	expectState(StateConnected)
+	if err := execute(); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	// Broken connections are redialed by the next command, since commands
+	// that may have reached the server are not retried.
+	srv.DropConns()
+	if err := execute(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable after dropped connection, got %v", err)
+	}
+	if err := execute(); err != nil {
+		t.Fatalf("execute after dropped connection: %v", err)
+	}
+	expectState(StateConnected)
+	if n := srv.Dials(); n != 2 {
+		t.Fatalf("expected 2 dials, got %d", n)
+	}
+
+	// Once the server goes down, commands fail...
+	srv.Close()
+	if err := execute(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	expectState(StateReconnecting)
+
+	// ...immediately, while backing off...
+	start := time.Now()
+	if err := execute(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if took := time.Since(start); took >= 50*time.Millisecond {
+		t.Fatalf("expected to fail fast, took %v", took)
+	}
+
+	// ...until the client gives up.
+	for i := 0; c.State() != StateFailed; i++ {
+		if i > 10 {
+			t.Fatalf("expected state %s, got %s", StateFailed, c.State())
+		}
+		time.Sleep(c.backoff())
+		if err := execute(); !errors.Is(err, ErrUnavailable) {
+			t.Fatalf("expected ErrUnavailable, got %v", err)
+		}
+	}
+
+	// Failed clients continue to redial the server.
+	srv.Restart()
+	time.Sleep(c.backoff())
+	if err := execute(); err != nil {
+		t.Fatalf("execute after restart: %v", err)
+	}
+	expectState(StateConnected)
+}
+
+// Commands whose responses are lost are not executed again, since they may not
+// be idempotent.
+func TestClientDoesNotResend(t *testing.T) {
+	var srv *stubServer
+	srv = newStubServer(t, func(cmd string) string {
+		srv.DropConns() // the server executes the command, but never responds
+		return cmd
+	})
+	c := NewClient(srv.Addr(), stubPassword)
+	defer c.Close()
+
+	if _, err := c.Execute(command.List()); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if n := len(srv.Commands()); n != 1 {
+		t.Fatalf("expected the command to be sent once, got %d", n)
+	}
+}
+
+func TestClientPool(t *testing.T) {
+	for _, size := range []int{1, 3} {
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			var (
+				mu             sync.Mutex
+				inFlight, peak int
+			)
+			srv := newStubServer(t, func(cmd string) string {
+				mu.Lock()
+				inFlight++
+				if inFlight > peak {
+					peak = inFlight
+				}
+				mu.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+				return cmd
+			})
+			c := NewClient(srv.Addr(), stubPassword, func(cfg *ClientConfig) {
+				cfg.PoolSize = size
+			})
+			defer c.Close()
+
+			// Concurrent callers each receive the output of their own
+			// command.
+			var wg sync.WaitGroup
+			for i := 0; i < 4*size; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					target, err := command.Player(fmt.Sprintf("Player%d", i))
+					if err != nil {
+						t.Errorf("build target: %v", err)
+						return
+					}
+					cmd, err := command.DataGetEntity(target, "Pos")
+					if err != nil {
+						t.Errorf("build command: %v", err)
+						return
+					}
+					out, err := c.Execute(cmd)
+					if err != nil {
+						t.Errorf("execute: %v", err)
+						return
+					}
+					if out != cmd.String() {
+						t.Errorf("expected output %q, got %q", cmd.String(), out)
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			// Commands run concurrently over at most size connections.
+			if n := srv.Dials(); n != size {
+				t.Errorf("expected %d dials, got %d", size, n)
+			}
+			if peak != size {
+				t.Errorf("expected %d commands in flight at most, got %d", size, peak)
+			}
+		})
+	}
+}
+
+func TestClientAuthFailed(t *testing.T) {
+	srv := newStubServer(t, echo)
+	c := NewClient(srv.Addr(), "wrong", func(cfg *ClientConfig) {
+		cfg.MinBackoff = time.Hour
+	})
+	if err := c.Connect(); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if s := c.State(); s != StateFailed {
+		t.Fatalf("expected state %s, got %s", StateFailed, s)
+	}
+}
+
+// Callers must not be blocked by other callers that are waiting to redial
+// the server.
+func TestClientFailsFastWhileDown(t *testing.T) {
+	srv := newStubServer(t, echo)
+	srv.Close()
+	c := NewClient(srv.Addr(), stubPassword, func(cfg *ClientConfig) {
+		cfg.MinBackoff = time.Second
+		cfg.MaxBackoff = 8 * time.Second
+		cfg.PoolSize = 1
+	})
+
+	var (
+		wg    sync.WaitGroup
+		start = time.Now()
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Execute(command.List()); !errors.Is(err, ErrUnavailable) {
+				t.Errorf("expected ErrUnavailable, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if took := time.Since(start); took >= time.Second {
+		t.Fatalf("expected callers to fail fast, took %v", took)
+	}
+}
+
+func TestServeHealth(t *testing.T) {
+	srv := newStubServer(t, echo)
+	c := NewClient(srv.Addr(), stubPassword)
+	defer c.Close()
+
+	check := func(wantCode int, wantBody string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		ServeHealth(c)(rec, httptest.NewRequest("GET", "/health", nil))
+		if rec.Code != wantCode || rec.Body.String() != wantBody {
+			t.Fatalf(
+				"expected %d %q, got %d %q",
+				wantCode, wantBody, rec.Code, rec.Body.String(),
+			)
+		}
+	}
+	check(http.StatusServiceUnavailable, `{"rcon":"reconnecting"}`+"\n")
+	if err := c.Connect(); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	check(http.StatusOK, `{"rcon":"connected"}`+"\n")
+}
@@ -0,0 +1,146 @@
+// Package command builds validated Minecraft commands.
+//
+// Commands can only be created through the builders in this package, which
+// validate and escape their arguments, so that user input cannot be used to
+// inject additional commands or arguments.
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Command is a Minecraft command that is safe to execute.
+type Command struct{ text string }
+
+func (c Command) String() string { return c.text }
+
+// IsZero reports whether c is the zero Command, which is not a valid command.
+func (c Command) IsZero() bool { return c.text == "" }
+
+func build(parts ...string) Command {
+	return Command{text: strings.Join(parts, " ")}
+}
+
+// List builds a command that lists the players on the server.
+func List() Command { return build("list") }
+
+// Version builds a command that prints the version of the server software.
+//
+// It is only supported by Bukkit-based servers (i.e. Spigot and Paper).
+func Version() Command { return build("version") }
+
+// TPS builds a command that prints the server's recent ticks per second.
+//
+// It is only supported by Spigot-based servers (i.e. Spigot and Paper).
+func TPS() Command { return build("tps") }
+
+// ForgeTPS builds a command that prints the server's mean tick time and ticks
+// per second.
+//
+// It is only supported by Forge servers.
+func ForgeTPS() Command { return build("forge", "tps") }
+
+// DataGetEntity builds a command that prints the data of an entity, or the tag
+// at path within it if path is non-empty.
+func DataGetEntity(target Target, path string) (Command, error) {
+	if err := target.validate(); err != nil {
+		return Command{}, err
+	}
+	if path == "" {
+		return build("data", "get", "entity", target.s), nil
+	}
+	if err := validatePath(path); err != nil {
+		return Command{}, err
+	}
+	return build("data", "get", "entity", target.s, path), nil
+}
+
+// Teleport builds a command that teleports target to the position (x, y, z).
+func Teleport(target Target, x, y, z float64) (Command, error) {
+	if err := target.validate(); err != nil {
+		return Command{}, err
+	}
+	pos, err := formatFloats(64, x, y, z)
+	if err != nil {
+		return Command{}, errors.Wrap(err, "position")
+	}
+	return build("tp", target.s, pos), nil
+}
+
+// TeleportRotated builds a command that teleports target to the position
+// (x, y, z), facing the given yaw and pitch (in degrees).
+func TeleportRotated(target Target, x, y, z float64, yaw, pitch float32) (Command, error) {
+	cmd, err := Teleport(target, x, y, z)
+	if err != nil {
+		return Command{}, err
+	}
+	rot, err := formatFloats(32, float64(yaw), float64(pitch))
+	if err != nil {
+		return Command{}, errors.Wrap(err, "rotation")
+	}
+	return build(cmd.text, rot), nil
+}
+
+// TeleportToEntity builds a command that teleports target to the position of
+// dest.
+func TeleportToEntity(target, dest Target) (Command, error) {
+	if err := target.validate(); err != nil {
+		return Command{}, err
+	}
+	if err := dest.validate(); err != nil {
+		return Command{}, errors.Wrap(err, "destination")
+	}
+	return build("tp", target.s, dest.s), nil
+}
+
+// Tellraw builds a command that sends a JSON text component to target.
+func Tellraw(target Target, component []byte) (Command, error) {
+	if err := target.validate(); err != nil {
+		return Command{}, err
+	}
+	if !json.Valid(component) {
+		return Command{}, invalidArgument(
+			errors.New("command: invalid JSON text component"),
+		)
+	}
+
+	// Compact the component, which also removes any newlines.
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, component); err != nil {
+		return Command{}, invalidArgument(err)
+	}
+	return build("tellraw", target.s, buf.String()), nil
+}
+
+func formatFloats(bitSize int, values ...float64) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return "", invalidArgument(errors.Newf("command: non-finite value %v", v))
+		}
+		parts[i] = strconv.FormatFloat(v, 'f', -1, bitSize)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// validatePath ensures that an NBT path is a single argument.
+func validatePath(path string) error {
+	for _, r := range path {
+		if r <= ' ' || r == 0x7f {
+			return invalidArgument(errors.Newf("command: invalid NBT path '%s'", path))
+		}
+	}
+	return nil
+}
+
+func invalidArgument(err error) error {
+	return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+}
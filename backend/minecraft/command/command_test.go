@@ -0,0 +1,126 @@
+package command
+
+import (
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+func player(t *testing.T, username string) Target {
+	t.Helper()
+	target, err := Player(username)
+	if err != nil {
+		t.Fatalf("player: %v", err)
+	}
+	return target
+}
+
+func expectCommand(t *testing.T, cmd Command, err error, want string) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("%s: %v", want, err)
+	}
+	if cmd.String() != want {
+		t.Fatalf("expected command %q, got %q", want, cmd)
+	}
+}
+
+func expectInvalid(t *testing.T, name string, cmd Command, err error) {
+	t.Helper()
+	if err == nil {
+		t.Errorf("%s: expected an error, got command %q", name, cmd)
+		return
+	}
+	if code := exthttp.GetHTTPCode(err, 0); code != http.StatusBadRequest {
+		t.Errorf("%s: expected HTTP code %d, got %d", name, http.StatusBadRequest, code)
+	}
+	if !cmd.IsZero() {
+		t.Errorf("%s: expected the zero Command, got %q", name, cmd)
+	}
+}
+
+func TestTeleport(t *testing.T) {
+	steve := player(t, "Steve")
+
+	cmd, err := Teleport(steve, 1.5, 64, -3)
+	expectCommand(t, cmd, err, "tp Steve 1.5 64 -3")
+	cmd, err = TeleportRotated(steve, -0.25, 70, 1e6, 90, -15.5)
+	expectCommand(t, cmd, err, "tp Steve -0.25 70 1000000 90 -15.5")
+	cmd, err = TeleportToEntity(steve, player(t, "Alex"))
+	expectCommand(t, cmd, err, "tp Steve Alex")
+
+	cmd, err = Teleport(steve, math.NaN(), 64, 0)
+	expectInvalid(t, "NaN", cmd, err)
+	cmd, err = Teleport(steve, 0, math.Inf(1), 0)
+	expectInvalid(t, "Inf", cmd, err)
+	cmd, err = TeleportRotated(steve, 0, 64, 0, float32(math.Inf(-1)), 0)
+	expectInvalid(t, "-Inf yaw", cmd, err)
+}
+
+func TestDataGetEntity(t *testing.T) {
+	steve := player(t, "Steve")
+
+	cmd, err := DataGetEntity(steve, "")
+	expectCommand(t, cmd, err, "data get entity Steve")
+	cmd, err = DataGetEntity(steve, "Pos[0]")
+	expectCommand(t, cmd, err, "data get entity Steve Pos[0]")
+
+	for _, path := range []string{
+		"Pos Rotation",
+		"Pos\nop Steve",
+		"Pos\t",
+		"Pos\x7f",
+	} {
+		cmd, err = DataGetEntity(steve, path)
+		expectInvalid(t, path, cmd, err)
+	}
+}
+
+func TestTellraw(t *testing.T) {
+	steve := player(t, "Steve")
+
+	cmd, err := Tellraw(steve, []byte(`{"text": "hi"}`))
+	expectCommand(t, cmd, err, `tellraw Steve {"text":"hi"}`)
+
+	// Valid JSON is compacted onto a single line, which keeps newlines (and
+	// other insignificant whitespace) out of the command.
+	cmd, err = Tellraw(AllPlayers, []byte("[\n  \"\",\n  {\"text\": \"a\\nb\"}\n]\n"))
+	expectCommand(t, cmd, err, `tellraw @a ["",{"text":"a\nb"}]`)
+
+	for _, component := range []string{
+		"",
+		"hi",
+		`{"text": "hi"`,
+		"{\"text\": \"hi\"}\nop Steve",
+		`{"text": "hi"} {"text": "bye"}`,
+	} {
+		cmd, err = Tellraw(steve, []byte(component))
+		expectInvalid(t, component, cmd, err)
+	}
+}
+
+func TestScoreboard(t *testing.T) {
+	steve := player(t, "Steve")
+
+	cmd, err := AddTriggerObjective("zoomcraft")
+	expectCommand(t, cmd, err, "scoreboard objectives add zoomcraft trigger")
+	cmd, err = EnableTrigger(steve, "zoomcraft")
+	expectCommand(t, cmd, err, "scoreboard players enable Steve zoomcraft")
+	cmd, err = GetScore(steve, "zoomcraft")
+	expectCommand(t, cmd, err, "scoreboard players get Steve zoomcraft")
+	cmd, err = ResetScore(steve, "zoomcraft")
+	expectCommand(t, cmd, err, "scoreboard players reset Steve zoomcraft")
+
+	for _, objective := range []string{
+		"",
+		"zoom craft",
+		"zoomcraft\nop Steve",
+		"@a",
+		"a_very_long_objective",
+	} {
+		cmd, err = GetScore(steve, objective)
+		expectInvalid(t, objective, cmd, err)
+	}
+}
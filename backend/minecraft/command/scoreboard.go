@@ -0,0 +1,57 @@
+package command
+
+import (
+	"regexp"
+
+	"github.com/cockroachdb/errors"
+)
+
+var objectivePattern = regexp.MustCompile(`^[A-Za-z0-9_.+-]{1,16}$`)
+
+// validateObjective ensures that objective is a valid scoreboard objective
+// name.
+func validateObjective(objective string) error {
+	if !objectivePattern.MatchString(objective) {
+		return invalidArgument(
+			errors.Newf("command: invalid objective '%s'", objective),
+		)
+	}
+	return nil
+}
+
+// AddTriggerObjective builds a command that creates a scoreboard objective
+// whose scores players can set with the /trigger command.
+func AddTriggerObjective(objective string) (Command, error) {
+	if err := validateObjective(objective); err != nil {
+		return Command{}, err
+	}
+	return build("scoreboard", "objectives", "add", objective, "trigger"), nil
+}
+
+// EnableTrigger builds a command that allows target to set its score for a
+// trigger objective once.
+func EnableTrigger(target Target, objective string) (Command, error) {
+	return buildScore("enable", target, objective)
+}
+
+// GetScore builds a command that prints the score of target for an
+// objective.
+func GetScore(target Target, objective string) (Command, error) {
+	return buildScore("get", target, objective)
+}
+
+// ResetScore builds a command that clears the score of target for an
+// objective.
+func ResetScore(target Target, objective string) (Command, error) {
+	return buildScore("reset", target, objective)
+}
+
+func buildScore(action string, target Target, objective string) (Command, error) {
+	if err := target.validate(); err != nil {
+		return Command{}, err
+	}
+	if err := validateObjective(objective); err != nil {
+		return Command{}, err
+	}
+	return build("scoreboard", "players", action, target.s, objective), nil
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	stderrors "errors"
+	"regexp"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Target identifies the entities that a command applies to.
+//
+// It is either a player username, or a target selector (i.e. "@a"). Since
+// selectors can match many entities, they are only accepted when constructed
+// explicitly through Selector.
+type Target struct{ s string }
+
+func (t Target) String() string { return t.s }
+
+func (t Target) validate() error {
+	if t.s == "" {
+		return invalidArgument(errors.New("command: empty target"))
+	}
+	return nil
+}
+
+var (
+	usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{3,16}$`)
+	selectorPattern = regexp.MustCompile(`^@[aeprs](\[[^\]\s\x00-\x1f\x7f]*\])?$`)
+)
+
+// Player returns a Target for the player with the given username.
+//
+// It fails with ErrInvalidUsername unless username follows Minecraft's rules:
+// 3 to 16 characters, each of which is a letter, digit, or underscore.
+func Player(username string) (Target, error) {
+	if err := ValidateUsername(username); err != nil {
+		return Target{}, err
+	}
+	return Target{s: username}, nil
+}
+
+// ValidateUsername checks that username follows Minecraft's rules for
+// usernames.
+func ValidateUsername(username string) error {
+	if !usernamePattern.MatchString(username) {
+		err := errors.Mark(
+			errors.Newf("command: invalid username '%s'", username),
+			ErrInvalidUsername,
+		)
+		return invalidArgument(err)
+	}
+	return nil
+}
+
+// Selector returns a Target for a target selector, such as "@a" or
+// "@e[type=minecraft:player,distance=..10]".
+func Selector(selector string) (Target, error) {
+	if !selectorPattern.MatchString(selector) {
+		err := errors.Mark(
+			errors.Newf("command: invalid selector '%s'", selector),
+			ErrInvalidSelector,
+		)
+		return Target{}, invalidArgument(err)
+	}
+	return Target{s: selector}, nil
+}
+
+// AllPlayers is a Target for all players on the server.
+var AllPlayers = Target{s: "@a"}
+
+var (
+	// ErrInvalidUsername is returned when a username does not follow
+	// Minecraft's rules.
+	ErrInvalidUsername = stderrors.New("command: invalid username")
+
+	// ErrInvalidSelector is returned when a target selector is malformed.
+	ErrInvalidSelector = stderrors.New("command: invalid selector")
+)
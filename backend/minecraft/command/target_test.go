@@ -0,0 +1,117 @@
+package command
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+func TestPlayer(t *testing.T) {
+	for _, username := range []string{
+		"Steve",
+		"abc",
+		"_jeb_",
+		"Notch_2011",
+		strings.Repeat("x", 16),
+	} {
+		target, err := Player(username)
+		if err != nil {
+			t.Errorf("%q: %v", username, err)
+			continue
+		}
+		if target.String() != username {
+			t.Errorf("%q: expected target %q, got %q", username, username, target)
+		}
+	}
+
+	for _, username := range []string{
+		"",
+		"ab",
+		strings.Repeat("x", 17),
+		"Steve Alex",
+		" Steve",
+		"Steve\n",
+		"Steve\nop Steve",
+		"Steve\r",
+		"Steve\x00",
+		"@a",
+		"@p[name=Steve]",
+		"Steve;op",
+		"Stéve",
+		"Steve-1",
+		"Steve.1",
+		"\"Steve\"",
+	} {
+		_, err := Player(username)
+		if !errors.Is(err, ErrInvalidUsername) {
+			t.Errorf("%q: expected ErrInvalidUsername, got %v", username, err)
+			continue
+		}
+		if code := exthttp.GetHTTPCode(err, 0); code != http.StatusBadRequest {
+			t.Errorf("%q: expected HTTP code %d, got %d", username, http.StatusBadRequest, code)
+		}
+		if err := ValidateUsername(username); !errors.Is(err, ErrInvalidUsername) {
+			t.Errorf("%q: expected ValidateUsername to fail, got %v", username, err)
+		}
+	}
+}
+
+func TestSelector(t *testing.T) {
+	for _, selector := range []string{
+		"@a",
+		"@e",
+		"@p",
+		"@r",
+		"@s",
+		"@a[]",
+		"@e[type=minecraft:player,distance=..10]",
+		"@a[name=Steve,limit=1]",
+	} {
+		target, err := Selector(selector)
+		if err != nil {
+			t.Errorf("%q: %v", selector, err)
+			continue
+		}
+		if target.String() != selector {
+			t.Errorf("%q: expected target %q, got %q", selector, selector, target)
+		}
+	}
+
+	for _, selector := range []string{
+		"",
+		"@",
+		"@x",
+		"@aa",
+		"a",
+		"Steve",
+		"@a ",
+		" @a",
+		"@a\n",
+		"@a\nop Steve",
+		"@a[",
+		"@a]",
+		"@a[name=Steve] op",
+		"@a[name=Steve]\nop Steve",
+		"@a[name=Steve Alex]",
+		"@a[name=Steve\n]",
+		"@a[name=Steve\x00]",
+		"@a[name=Steve][limit=1]",
+	} {
+		_, err := Selector(selector)
+		if !errors.Is(err, ErrInvalidSelector) {
+			t.Errorf("%q: expected ErrInvalidSelector, got %v", selector, err)
+		}
+	}
+}
+
+func TestTargetZero(t *testing.T) {
+	if _, err := Teleport(Target{}, 0, 0, 0); err == nil {
+		t.Error("expected the zero Target to be rejected")
+	}
+	if _, err := TeleportToEntity(AllPlayers, Target{}); err == nil {
+		t.Error("expected the zero Target to be rejected as a destination")
+	}
+}
@@ -0,0 +1,79 @@
+package minecraft
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Dimension identifies a dimension of a Minecraft world by its namespaced
+// ID, i.e. "minecraft:overworld".
+type Dimension string
+
+// The set of vanilla Dimensions.
+const (
+	DimensionOverworld Dimension = "minecraft:overworld"
+	DimensionNether    Dimension = "minecraft:the_nether"
+	DimensionEnd       Dimension = "minecraft:the_end"
+)
+
+// ParseDimension parses a Dimension from a string.
+//
+// IDs without a namespace are assumed to belong to the "minecraft" namespace.
+func ParseDimension(s string) (Dimension, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", errors.New("minecraft: empty dimension")
+	}
+	if strings.IndexByte(s, ':') < 0 {
+		s = "minecraft:" + s
+	}
+	return Dimension(s), nil
+}
+
+// dimensionFromLegacyID converts the numeric dimension IDs used by servers
+// prior to 1.16 into a Dimension.
+func dimensionFromLegacyID(id int) Dimension {
+	switch id {
+	case -1:
+		return DimensionNether
+	case 1:
+		return DimensionEnd
+	default:
+		return DimensionOverworld
+	}
+}
+
+func (d Dimension) String() string { return string(d) }
+
+var (
+	_ graphql.Marshaler   = (*Dimension)(nil)
+	_ graphql.Unmarshaler = (*Dimension)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (d Dimension) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(d)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (d *Dimension) UnmarshalGQL(v interface{}) (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.WithDetail(err, "Failed to parse Dimension.")
+			err = exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+	}()
+
+	s, ok := v.(string)
+	if !ok {
+		return errors.Newf("minecraft: unsupported field type %T", v)
+	}
+	*d, err = ParseDimension(s)
+	return err
+}
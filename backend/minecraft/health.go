@@ -0,0 +1,24 @@
+package minecraft
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ServeHealth creates an http.HandlerFunc that reports the ConnState of c, as
+// a JSON object of the form {"rcon": "connected"}.
+//
+// The response has a 503 status unless c is connected, so that it can be used
+// as a health check.
+func ServeHealth(c *Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		state := c.State()
+		w.Header().Set("Content-Type", "application/json")
+		if state != StateConnected {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(struct {
+			RCON string `json:"rcon"`
+		}{state.String()})
+	}
+}
@@ -0,0 +1,92 @@
+package minecraft
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// MaxMessageLength is the maximum length of the text in a message, in
+// characters.
+const MaxMessageLength = 256
+
+// A MessageService can send chat messages to Players on a server.
+type MessageService interface {
+	// Tell sends msg to the player with the given username.
+	Tell(ctx context.Context, username string, msg text.Component) error
+
+	// Broadcast sends msg to all players.
+	Broadcast(ctx context.Context, msg text.Component) error
+}
+
+type messageService struct {
+	client *Client
+	logger log.Logger
+}
+
+// NewMessageService creates a MessageService.
+func NewMessageService(c *Client, logger log.Logger) MessageService {
+	return &messageService{
+		client: c,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (svc *messageService) Tell(
+	_ context.Context,
+	username string,
+	msg text.Component,
+) (err error) {
+	logger := log.With(svc.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Tell", err)
+	}(time.Now())
+
+	target, err := command.Player(username)
+	if err != nil {
+		return err
+	}
+	return svc.send(target, msg)
+}
+
+func (svc *messageService) Broadcast(_ context.Context, msg text.Component) (err error) {
+	defer func(start time.Time) {
+		l := log.With(svc.logger, "took", time.Since(start))
+		logutil.Trace(l, "Broadcast", err)
+	}(time.Now())
+	return svc.send(command.AllPlayers, msg)
+}
+
+func (svc *messageService) send(target command.Target, msg text.Component) error {
+	if n := utf8.RuneCountInString(msg.String()); n > MaxMessageLength {
+		err := errors.Newf(
+			"minecraft: message is too long (%d > %d characters)",
+			n, MaxMessageLength,
+		)
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	cmd, err := command.Tellraw(target, msg.JSON())
+	if err != nil {
+		return errors.Wrap(err, "build command")
+	}
+	out, err := svc.client.Execute(cmd)
+	if err != nil {
+		return errors.Wrap(err, "execute command")
+	}
+	if out != "" { // tellraw produces no output when successful
+		return commandError(out)
+	}
+	return nil
+}
@@ -1,14 +1,45 @@
 package minecraft
 
-import "context"
+import (
+	"context"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
 
 // A Player describes a player entity in Minecraft.
 type Player struct {
 	Username    string      `json:"username"`
 	Position    Coordinates `json:"position"`
 	Orientation Orientation `json:"orientation"`
+	Dimension   Dimension   `json:"dimension"`
+
+	// PositionUnknown is true if the Position, Orientation, and Dimension of
+	// the player are unknown (and zero), i.e. because it was read from the
+	// server's status rather than through RCON.
+	PositionUnknown bool `json:"positionUnknown,omitempty"`
+}
+
+// Entity returns p as a presence.Entity, whose ID is the player's username and
+// whose Space is the player's dimension.
+func (p *Player) Entity() *presence.Entity {
+	return &presence.Entity{
+		ID:              p.Username,
+		Space:           string(p.Dimension),
+		Position:        p.Position.Presence(),
+		Orientation:     p.Orientation.Presence(),
+		PositionUnknown: p.PositionUnknown,
+	}
 }
 
+// Block returns the position of the block that p is standing in.
+func (p *Player) Block() BlockPosition { return p.Position.Block() }
+
+// Chunk returns the position of the chunk that p is in.
+func (p *Player) Chunk() ChunkPosition { return p.Position.Chunk() }
+
+// Region returns the position of the region that p is in.
+func (p *Player) Region() RegionPosition { return p.Position.Region() }
+
 // A PlayerService can get information about the Players on a server.
 type PlayerService interface {
 	Get(ctx context.Context, username string) (*Player, error)
@@ -3,8 +3,6 @@ package minecraft
 import (
 	"context"
 	stderrors "errors"
-	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -12,20 +10,46 @@ import (
 	"github.com/go-kit/kit/log/level"
 
 	"github.com/cockroachdb/errors"
+	"golang.org/x/sync/errgroup"
 
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/snbt"
+	"go.stevenxie.me/zoomcraft/backend/types"
 	"go.stevenxie.me/zoomcraft/backend/util/logutil"
 )
 
 type playerService struct {
 	client *Client
 	logger log.Logger
+	cfg    PlayerServiceConfig
+}
+
+// PlayerServiceConfig configures a PlayerService.
+type PlayerServiceConfig struct {
+	// Concurrency is the maximum number of players fetched concurrently by
+	// List.
+	//
+	// It defaults to the pool size of the underlying Client.
+	Concurrency int
 }
 
 // NewPlayerService creates a PlayerService.
-func NewPlayerService(c *Client, logger log.Logger) PlayerService {
+func NewPlayerService(
+	c *Client,
+	logger log.Logger,
+	opts ...func(*PlayerServiceConfig),
+) PlayerService {
+	cfg := PlayerServiceConfig{Concurrency: c.PoolSize()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
 	return &playerService{
 		client: c,
 		logger: level.NewInjector(logger, level.DebugValue()),
+		cfg:    cfg,
 	}
 }
 
@@ -44,16 +68,40 @@ func (svc *playerService) List(ctx context.Context) (_ []*Player, err error) {
 		logutil.Log(l, "discovered %d players", len(usernames))
 	}
 
-	players := make([]*Player, 0, len(usernames))
-	for _, u := range usernames {
-		player, err := svc.Get(ctx, u)
-		if err != nil {
-			if err == ErrNotFound {
-				continue
+	// Fetch players concurrently, up to the configured limit.
+	var (
+		results = make([]*Player, len(usernames))
+		sem     = make(chan types.Empty, svc.cfg.Concurrency)
+		group   errgroup.Group
+	)
+	for i, u := range usernames {
+		i, u := i, u
+		sem <- types.Empty{}
+		group.Go(func() error {
+			defer func() { <-sem }()
+			player, err := svc.Get(ctx, u)
+			if err != nil {
+				// Skip players that have disconnected, or whose usernames
+				// cannot be safely used in commands.
+				if errors.Is(err, ErrNotFound) ||
+					errors.Is(err, command.ErrInvalidUsername) {
+					return nil
+				}
+				return errors.Wrapf(err, "get position for '%s'", u)
 			}
-			return nil, errors.Wrapf(err, "get position for '%s'", u)
+			results[i] = player
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	players := make([]*Player, 0, len(results))
+	for _, p := range results {
+		if p != nil { // player disconnected
+			players = append(players, p)
 		}
-		players = append(players, player)
 	}
 	return players, nil
 }
@@ -64,81 +112,87 @@ func (svc *playerService) Get(_ context.Context, username string) (_ *Player, er
 		l := log.With(logger, "took", time.Since(start))
 		logutil.Trace(l, "Get", err)
 	}(time.Now())
-	pos, err := svc.getPosition(username)
-	if err != nil {
-		return nil, errors.Wrap(err, "position")
+
+	// Fetch all entity data in a single round trip.
+	var data entityData
+	if err = svc.getEntityData(username, &data); err != nil {
+		return nil, err
 	}
-	rot, err := svc.getRotation(username)
+	dim, err := data.dimension()
 	if err != nil {
-		return nil, errors.Wrap(err, "rotation")
+		return nil, errors.Wrap(err, "parse dimension")
 	}
 	return &Player{
-		Username:    username,
-		Position:    *pos,
-		Orientation: *rot,
+		Username:  username,
+		Dimension: dim,
+		Position: Coordinates{
+			X: data.Pos[0],
+			Y: data.Pos[1],
+			Z: data.Pos[2],
+		},
+		Orientation: Orientation{
+			X: data.Rotation[0],
+			Y: data.Rotation[1],
+		},
 	}, nil
 }
 
-func (svc *playerService) getPosition(username string) (*Coordinates, error) {
-	cmd := fmt.Sprintf("data get entity %s Pos", username)
-	out, err := svc.client.Execute(cmd)
-	if err != nil {
-		return nil, errors.Wrap(err, "execute command")
-	}
-	if out == "No entity was found" { // player disconnected
-		return nil, ErrNotFound
-	}
+// entityData is the subset of a player's entity data used to build a Player.
+type entityData struct {
+	Pos      [3]float64 `nbt:"Pos"`
+	Rotation [2]float32 `nbt:"Rotation"`
 
-	// Parse output.
-	out = out[strings.LastIndexByte(out, ':')+2:]
-	out = strings.Trim(out, "[]")
+	// Dimension is a string ID on 1.16+ servers, and a numeric ID on older
+	// servers.
+	Dimension interface{} `nbt:"Dimension"`
+}
 
-	// Parse output parts into pos.
-	var (
-		pos   = new(Coordinates)
-		parts = []*float64{&pos.X, &pos.Y, &pos.Z}
-	)
-	for i, part := range strings.Split(out, ", ") {
-		f, err := strconv.ParseFloat(part[:len(part)-1], 64)
-		if err != nil {
-			return nil, errors.Wrap(err, "parse coordinate part")
-		}
-		*(parts[i]) = f
+func (data *entityData) dimension() (Dimension, error) {
+	switch dim := data.Dimension.(type) {
+	case string:
+		return ParseDimension(dim)
+	case int32:
+		return dimensionFromLegacyID(int(dim)), nil
+	case nil:
+		return DimensionOverworld, nil
+	default:
+		return "", errors.Newf("minecraft: unexpected dimension type %T", dim)
 	}
-	return pos, nil
 }
 
-func (svc *playerService) getRotation(username string) (*Orientation, error) {
-	cmd := fmt.Sprintf("data get entity %s Rotation", username)
+// getEntityData decodes the entity data of the player with the given username
+// into v.
+func (svc *playerService) getEntityData(username string, v interface{}) error {
+	target, err := command.Player(username)
+	if err != nil {
+		return err
+	}
+	cmd, err := command.DataGetEntity(target, "")
+	if err != nil {
+		return errors.Wrap(err, "build command")
+	}
 	out, err := svc.client.Execute(cmd)
 	if err != nil {
-		return nil, errors.Wrap(err, "execute command")
+		return errors.Wrap(err, "execute command")
 	}
 	if out == "No entity was found" { // player disconnected
-		return nil, ErrNotFound
+		return ErrNotFound
 	}
 
-	// Parse output.
-	out = out[strings.LastIndexByte(out, ':')+2:]
-	out = strings.Trim(out, "[]")
-
-	// Parse output parts into orient.
-	var (
-		orient = new(Orientation)
-		parts  = []*float32{&orient.X, &orient.Y}
-	)
-	for i, part := range strings.Split(out, ", ") {
-		f, err := strconv.ParseFloat(part[:len(part)-1], 32)
-		if err != nil {
-			return nil, errors.Wrap(err, "parse rotation part")
-		}
-		*(parts[i]) = float32(f)
+	// Output is of the form "<username> has the following entity data: {...}".
+	const marker = " has the following entity data: "
+	i := strings.Index(out, marker)
+	if i < 0 {
+		return errors.Newf("minecraft: unexpected output '%s'", out)
+	}
+	if err = snbt.Unmarshal(out[i+len(marker):], v); err != nil {
+		return errors.Wrap(err, "decode entity data")
 	}
-	return orient, nil
+	return nil
 }
 
 func (svc *playerService) listUsernames() ([]string, error) {
-	out, err := svc.client.Execute("list")
+	out, err := svc.client.Execute(command.List())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,95 @@
+package minecraft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+)
+
+// stubWorld answers the commands used by a PlayerService, like a 1.16 server
+// with n players online.
+func stubWorld(n int) func(string) string {
+	usernames := make([]string, n)
+	for i := range usernames {
+		usernames[i] = fmt.Sprintf("Player%d", i+1)
+	}
+	return func(cmd string) string {
+		if cmd == "list" {
+			return fmt.Sprintf(
+				"There are %d of a max of 20 players online: %s",
+				n, strings.Join(usernames, ", "),
+			)
+		}
+		var username string
+		if _, err := fmt.Sscanf(cmd, "data get entity %s", &username); err != nil {
+			return "Unknown or incomplete command, see below for error"
+		}
+		return username + " has the following entity data: " +
+			`{Brain: {memories: {}}, HurtByTimestamp: 0, ` +
+			`Dimension: "minecraft:the_nether", ` +
+			`Rotation: [-90.5f, 12.25f], XpLevel: 0, ` +
+			`Pos: [1.5d, 64.0d, -20.25d], Inventory: []}`
+	}
+}
+
+func TestPlayerServiceList(t *testing.T) {
+	srv := newStubServer(t, stubWorld(3))
+	c := NewClient(srv.Addr(), stubPassword)
+	defer c.Close()
+
+	players, err := NewPlayerService(c, log.NewNopLogger()).List(context.Background())
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(players) != 3 {
+		t.Fatalf("expected 3 players, got %d", len(players))
+	}
+	want := Player{
+		Username:    "Player2",
+		Dimension:   DimensionNether,
+		Position:    Coordinates{X: 1.5, Y: 64, Z: -20.25},
+		Orientation: Orientation{X: -90.5, Y: 12.25},
+	}
+	if p := players[1]; *p != want {
+		t.Fatalf("expected %+v, got %+v", want, *p)
+	}
+
+	// Each player is read in a single round trip.
+	if n := len(srv.Commands()); n != 1+3 {
+		t.Fatalf("expected %d commands, got %d", 1+3, n)
+	}
+}
+
+// BenchmarkList reports the number of commands sent per List, which is one
+// per player (plus one to list usernames), rather than the two per player that
+// separate reads of Pos and Rotation would take.
+func BenchmarkList(b *testing.B) {
+	for _, n := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("players=%d", n), func(b *testing.B) {
+			srv := newStubServer(b, stubWorld(n))
+			c := NewClient(srv.Addr(), stubPassword, func(cfg *ClientConfig) {
+				cfg.PoolSize = 4
+			})
+			defer c.Close()
+			svc := NewPlayerService(c, log.NewNopLogger())
+
+			ctx := context.Background()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := svc.List(ctx); err != nil {
+					b.Fatalf("list: %v", err)
+				}
+			}
+			b.StopTimer()
+
+			commands := float64(len(srv.Commands())) / float64(b.N)
+			if commands != float64(1+n) {
+				b.Fatalf("expected %d commands per op, got %.2f", 1+n, commands)
+			}
+			b.ReportMetric(commands, "commands/op")
+		})
+	}
+}
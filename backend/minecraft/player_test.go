@@ -0,0 +1,33 @@
+package minecraft
+
+import (
+	"testing"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+func TestPlayerEntity(t *testing.T) {
+	p := Player{
+		Username:    "Steve",
+		Position:    Coordinates{X: 1.5, Y: 64, Z: -20.25},
+		Orientation: Orientation{X: -90.5, Y: 12.25},
+		Dimension:   DimensionNether,
+	}
+	want := presence.Entity{
+		ID:          "Steve",
+		Space:       string(DimensionNether),
+		Position:    presence.Position{X: 1.5, Y: 64, Z: -20.25},
+		Orientation: presence.Orientation{Yaw: -90.5, Pitch: 12.25},
+	}
+	if e := p.Entity(); *e != want {
+		t.Fatalf("expected entity %+v, got %+v", want, *e)
+	}
+
+	// Conversions to and from presence types are lossless.
+	if c := CoordinatesOf(want.Position); c != p.Position {
+		t.Errorf("expected coordinates %v, got %v", p.Position, c)
+	}
+	if o := OrientationOf(want.Orientation); o != p.Orientation {
+		t.Errorf("expected orientation %v, got %v", p.Orientation, o)
+	}
+}
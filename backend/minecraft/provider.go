@@ -0,0 +1,103 @@
+package minecraft
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+type provider struct {
+	players PlayerService
+}
+
+// NewProvider creates a presence.Provider that reports the Players from
+// players as Entities (see Player.Entity).
+func NewProvider(players PlayerService) presence.Provider {
+	return &provider{players: players}
+}
+
+func (p *provider) Get(ctx context.Context, id string) (*presence.Entity, error) {
+	player, err := p.players.Get(ctx, id)
+	if err != nil {
+		return nil, providerError(err)
+	}
+	return validEntity(player)
+}
+
+func (p *provider) List(ctx context.Context) ([]*presence.Entity, error) {
+	players, err := p.players.List(ctx)
+	if err != nil {
+		return nil, providerError(err)
+	}
+	entities := make([]*presence.Entity, len(players))
+	for i, player := range players {
+		entities[i] = player.Entity()
+	}
+	return entities, nil
+}
+
+// validEntity returns player as an Entity, or an error if the Entity is
+// invalid (i.e. the server reported a non-finite position).
+func validEntity(player *Player) (*presence.Entity, error) {
+	e := player.Entity()
+	if err := e.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "minecraft: player '%s'", player.Username)
+	}
+	return e, nil
+}
+
+type teleporter struct {
+	teleports TeleportService
+}
+
+// NewTeleporter creates a presence.Teleporter that moves Players using
+// teleports.
+func NewTeleporter(teleports TeleportService) presence.Teleporter {
+	return &teleporter{teleports: teleports}
+}
+
+func (t *teleporter) Teleport(
+	ctx context.Context,
+	id string,
+	pos presence.Position,
+	orient *presence.Orientation,
+) (*presence.Entity, error) {
+	var o *Orientation
+	if orient != nil {
+		rot := OrientationOf(*orient)
+		o = &rot
+	}
+	player, err := t.teleports.Teleport(ctx, id, CoordinatesOf(pos), o)
+	if err != nil {
+		return nil, err
+	}
+	return validEntity(player)
+}
+
+func (t *teleporter) TeleportTo(
+	ctx context.Context,
+	id, target string,
+) (*presence.Entity, error) {
+	player, err := t.teleports.TeleportTo(ctx, id, target)
+	if err != nil {
+		return nil, err
+	}
+	return validEntity(player)
+}
+
+// providerError converts err to the equivalent presence error, if any.
+//
+// ErrNotFound is replaced rather than marked, so that it can be detected by
+// errors.Is in the standard library.
+func providerError(err error) error {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return presence.ErrNotFound
+	case errors.Is(err, ErrUnavailable):
+		return errors.Mark(err, presence.ErrUnavailable)
+	default:
+		return err
+	}
+}
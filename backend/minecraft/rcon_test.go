@@ -0,0 +1,132 @@
+package minecraft
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/rconserver"
+)
+
+const stubPassword = "minecraft"
+
+// A stubServer is a fake RCON server, which answers commands using respond.
+// It can be stopped and restarted, to test how clients recover.
+type stubServer struct {
+	tb      testing.TB
+	addr    string
+	respond func(cmd string) string
+
+	mu       sync.Mutex
+	cancel   context.CancelFunc
+	conns    map[net.Conn]bool
+	dials    int
+	commands []string
+}
+
+// newStubServer starts a stubServer on a loopback address, which is closed
+// when the test completes.
+func newStubServer(tb testing.TB, respond func(cmd string) string) *stubServer {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatalf("listen: %v", err)
+	}
+	s := &stubServer{
+		tb:      tb,
+		addr:    lis.Addr().String(),
+		respond: respond,
+		conns:   make(map[net.Conn]bool),
+	}
+	s.serve(lis)
+	tb.Cleanup(s.Close)
+	return s
+}
+
+func (s *stubServer) serve(lis net.Listener) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mu.Lock()
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	srv := rconserver.New(
+		rconserver.HandlerFunc(s.execute),
+		stubPassword,
+		log.NewNopLogger(),
+	)
+	go srv.Serve(ctx, trackingListener{Listener: lis, s: s})
+}
+
+func (s *stubServer) execute(cmd string) string {
+	s.mu.Lock()
+	s.commands = append(s.commands, cmd)
+	s.mu.Unlock()
+	return s.respond(cmd)
+}
+
+// A trackingListener records the connections that it accepts in s.
+type trackingListener struct {
+	net.Listener
+	s *stubServer
+}
+
+func (l trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.s.mu.Lock()
+	l.s.conns[conn] = true
+	l.s.dials++
+	l.s.mu.Unlock()
+	return conn, nil
+}
+
+// Addr returns the address that the server listens on.
+func (s *stubServer) Addr() string { return s.addr }
+
+// Dials returns the number of connections that the server has accepted.
+func (s *stubServer) Dials() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dials
+}
+
+// Commands returns the commands that the server has received.
+func (s *stubServer) Commands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.commands...)
+}
+
+// DropConns closes all open connections, as a server does when it restarts.
+func (s *stubServer) DropConns() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+}
+
+// Close stops the server, and drops its connections.
+func (s *stubServer) Close() {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	s.mu.Unlock()
+	s.DropConns()
+}
+
+// Restart restarts a closed server on its original address.
+func (s *stubServer) Restart() {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		s.tb.Fatalf("listen: %v", err)
+	}
+	s.serve(lis)
+}
@@ -0,0 +1,195 @@
+// Package rconserver serves commands over RCON, in the same way that a vanilla
+// Minecraft server does.
+package rconserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// A Handler executes the commands that a Server receives.
+type Handler interface {
+	// Execute executes cmd, and returns its output.
+	Execute(cmd string) string
+}
+
+// A HandlerFunc is a Handler that executes commands by calling itself.
+type HandlerFunc func(cmd string) string
+
+// Execute implements Handler.
+func (f HandlerFunc) Execute(cmd string) string { return f(cmd) }
+
+// A Server serves a Handler over RCON.
+type Server struct {
+	handler  Handler
+	password string
+	logger   log.Logger
+}
+
+// New creates a Server that executes the commands of clients that
+// authenticate with password using h.
+func New(h Handler, password string, logger log.Logger) *Server {
+	return &Server{
+		handler:  h,
+		password: password,
+		logger:   level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+// Serve accepts connections on lis until ctx is cancelled, at which point lis
+// and all connections are closed.
+func (s *Server) Serve(ctx context.Context, lis net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	{
+		l := log.With(s.logger, "addr", lis.Addr())
+		logutil.Log(l, "serving RCON")
+	}
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "rconserver: accept")
+		}
+		go s.serveConn(ctx, conn)
+	}
+}
+
+// The set of RCON packet types.
+const (
+	packetTypeResponse int32 = 0
+	packetTypeCommand  int32 = 2
+	packetTypeAuth     int32 = 3
+
+	// Responses to authentication requests use the same type as commands.
+	packetTypeAuthResponse = packetTypeCommand
+)
+
+const (
+	// maxRequestLength is the maximum length of a request packet that vanilla
+	// servers accept.
+	maxRequestLength = 1460
+
+	// minPacketLength is the length of a packet with an empty body.
+	minPacketLength = 10
+)
+
+type packet struct {
+	ID   int32
+	Type int32
+	Body string
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	done := make(chan types.Empty)
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		conn.Close()
+	}()
+
+	logger := log.With(s.logger, "remote", conn.RemoteAddr())
+	if err := s.handle(conn); err != nil && !errors.Is(err, io.EOF) &&
+		ctx.Err() == nil {
+		logutil.Log(logutil.WithError(logger, err), "connection failed")
+	}
+}
+
+func (s *Server) handle(conn net.Conn) error {
+	var (
+		r      = bufio.NewReader(conn)
+		authed bool
+	)
+	for {
+		req, err := readPacket(r)
+		if err != nil {
+			return err
+		}
+
+		var res packet
+		switch {
+		case req.Type == packetTypeAuth:
+			res = packet{ID: req.ID, Type: packetTypeAuthResponse}
+			if authed = req.Body == s.password; !authed {
+				res.ID = -1
+			}
+		case req.Type == packetTypeCommand && authed:
+			start := time.Now()
+			res = packet{
+				ID:   req.ID,
+				Type: packetTypeResponse,
+				Body: s.handler.Execute(req.Body),
+			}
+			level.Debug(s.logger).Log(
+				"msg", "executed command",
+				"command", req.Body,
+				"took", time.Since(start),
+			)
+		default:
+			// Vanilla servers drop unauthenticated clients, and ignore packets
+			// of unknown types.
+			if !authed {
+				return errors.New("rconserver: client is not authenticated")
+			}
+			continue
+		}
+		if err = writePacket(conn, res); err != nil {
+			return err
+		}
+	}
+}
+
+// readPacket reads a packet of the form:
+//
+//	length int32 | id int32 | type int32 | body []byte | 0x00 0x00
+//
+// where integers are little-endian, and length counts all fields after it.
+func readPacket(r *bufio.Reader) (packet, error) {
+	var length int32
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return packet{}, err
+	}
+	if length < minPacketLength || length > maxRequestLength {
+		return packet{}, errors.Newf("rconserver: invalid packet length %d", length)
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return packet{}, errors.Wrap(err, "rconserver: read packet")
+	}
+	return packet{
+		ID:   int32(binary.LittleEndian.Uint32(buf[0:4])),
+		Type: int32(binary.LittleEndian.Uint32(buf[4:8])),
+		Body: string(bytes.TrimRight(buf[8:], "\x00")),
+	}, nil
+}
+
+func writePacket(w io.Writer, p packet) error {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, int32(minPacketLength+len(p.Body)))
+	binary.Write(&buf, binary.LittleEndian, p.ID)
+	binary.Write(&buf, binary.LittleEndian, p.Type)
+	buf.WriteString(p.Body)
+	buf.Write([]byte{0, 0})
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,24 @@
+package minecraft
+
+import "context"
+
+// A Server describes the status of a Minecraft server.
+//
+// Fields that the server does not expose are nil.
+type Server struct {
+	Version       *string `json:"version"`
+	MOTD          *string `json:"motd"`
+	OnlinePlayers int     `json:"onlinePlayers"`
+	MaxPlayers    int     `json:"maxPlayers"`
+
+	// TickTime is the mean time taken by a server tick, in milliseconds.
+	TickTime *float64 `json:"tickTime"`
+
+	// TPS is the mean number of ticks per second, which is at most 20.
+	TPS *float64 `json:"tps"`
+}
+
+// A ServerService can get the status of a Server.
+type ServerService interface {
+	Get(ctx context.Context) (*Server, error)
+}
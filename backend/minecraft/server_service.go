@@ -0,0 +1,193 @@
+package minecraft
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sync/errgroup"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+type serverService struct {
+	client *Client
+	logger log.Logger
+}
+
+// NewServerService creates a ServerService.
+//
+// Player counts are read using the vanilla `list` command. The version and
+// tick rate are read using commands provided by Bukkit, Spigot, and Forge,
+// when they are available; vanilla servers do not expose them over RCON.
+// Vanilla servers also do not expose their MOTD over RCON, so it is always
+// nil.
+func NewServerService(c *Client, logger log.Logger) ServerService {
+	return &serverService{
+		client: c,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (svc *serverService) Get(_ context.Context) (_ *Server, err error) {
+	defer func(start time.Time) {
+		l := log.With(svc.logger, "took", time.Since(start))
+		logutil.Trace(l, "Get", err)
+	}(time.Now())
+
+	var (
+		server Server
+		group  errgroup.Group
+	)
+	group.Go(func() (err error) {
+		server.OnlinePlayers, server.MaxPlayers, err = svc.playerCounts()
+		return errors.Wrap(err, "get player counts")
+	})
+	group.Go(func() (err error) {
+		server.Version, err = svc.version()
+		return errors.Wrap(err, "get version")
+	})
+	group.Go(func() (err error) {
+		server.TickTime, server.TPS, err = svc.tickRate()
+		return errors.Wrap(err, "get tick rate")
+	})
+	if err = group.Wait(); err != nil {
+		return nil, err
+	}
+	return &server, nil
+}
+
+var listPattern = regexp.MustCompile(
+	`^There are (\d+)(?: of a max(?: of)? |/| out of maximum )(\d+) players online`,
+)
+
+func (svc *serverService) playerCounts() (online, max int, err error) {
+	out, err := svc.client.Execute(command.List())
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "execute command")
+	}
+
+	// Output is of the form "There are 1 of a max of 20 players online: ..."
+	// on 1.13+ servers, and "There are 1/20 players online: ..." on older
+	// servers. EssentialsX uses "There are 1 out of maximum 20 players online."
+	m := listPattern.FindStringSubmatch(text.StripCodes(out))
+	if m == nil {
+		return 0, 0, errors.Newf("minecraft: unexpected output '%s'", out)
+	}
+	if online, err = strconv.Atoi(m[1]); err != nil {
+		return 0, 0, errors.Wrap(err, "parse online players")
+	}
+	if max, err = strconv.Atoi(m[2]); err != nil {
+		return 0, 0, errors.Wrap(err, "parse max players")
+	}
+	return online, max, nil
+}
+
+var versionPattern = regexp.MustCompile(`\(MC: ([^)]+)\)`)
+
+// version returns the Minecraft version of the server, or nil if it is
+// unavailable.
+func (svc *serverService) version() (*string, error) {
+	out, err := svc.execOptional(command.Version())
+	if err != nil || out == "" {
+		return nil, err
+	}
+
+	// Output is of the form "This server is running Paper version
+	// git-Paper-123 (MC: 1.16.5) (Implementing API version ...)".
+	m := versionPattern.FindStringSubmatch(out)
+	if m == nil {
+		level.Debug(svc.logger).Log(
+			"msg", "unrecognized version output",
+			"output", out,
+		)
+		return nil, nil
+	}
+	return &m[1], nil
+}
+
+var (
+	forgeTPSPatterns = []*regexp.Regexp{
+		// i.e. "Overall: Mean tick time: 1.234 ms. Mean TPS: 20.000"
+		regexp.MustCompile(
+			`Overall\s*:\s*Mean tick time:\s*([\d.]+)\s*ms\.\s*Mean TPS:\s*([\d.]+)`,
+		),
+		// i.e. "Overall: 20.000 TPS (1.234 ms/tick)"
+		regexp.MustCompile(`Overall\s*:\s*([\d.]+)\s*TPS\s*\(([\d.]+)\s*ms/tick\)`),
+	}
+	spigotTPSPattern = regexp.MustCompile(`TPS from last [^:]*:\s*\*?([\d.]+)`)
+)
+
+// tickRate returns the mean tick time and TPS of the server, either of which
+// is nil if it is unavailable.
+func (svc *serverService) tickRate() (tickTime, tps *float64, err error) {
+	out, err := svc.execOptional(command.ForgeTPS())
+	if err != nil {
+		return nil, nil, err
+	}
+	if out != "" {
+		for i, pattern := range forgeTPSPatterns {
+			m := pattern.FindStringSubmatch(out)
+			if m == nil {
+				continue
+			}
+			mspt, tpsText := m[1], m[2]
+			if i == 1 {
+				mspt, tpsText = m[2], m[1]
+			}
+			if tickTime, err = parseFloatPtr(mspt); err != nil {
+				return nil, nil, errors.Wrap(err, "parse tick time")
+			}
+			if tps, err = parseFloatPtr(tpsText); err != nil {
+				return nil, nil, errors.Wrap(err, "parse TPS")
+			}
+			return tickTime, tps, nil
+		}
+	}
+
+	// Spigot reports TPS over the last 1, 5, and 15 minutes, of which we use
+	// the first. It does not report tick times.
+	if out, err = svc.execOptional(command.TPS()); err != nil || out == "" {
+		return nil, nil, err
+	}
+	m := spigotTPSPattern.FindStringSubmatch(out)
+	if m == nil {
+		level.Debug(svc.logger).Log("msg", "unrecognized TPS output", "output", out)
+		return nil, nil, nil
+	}
+	if tps, err = parseFloatPtr(m[1]); err != nil {
+		return nil, nil, errors.Wrap(err, "parse TPS")
+	}
+	return nil, tps, nil
+}
+
+// execOptional executes a command that the server may not support, returning
+// its output stripped of formatting codes, or "" if it is unsupported.
+func (svc *serverService) execOptional(cmd command.Command) (string, error) {
+	out, err := svc.client.Execute(cmd)
+	if err != nil {
+		return "", errors.Wrap(err, "execute command")
+	}
+	// i.e. "Unknown or incomplete command, see below for error" on vanilla
+	// servers, and "Unknown command. Type "/help" for help." on Bukkit servers.
+	if out = text.StripCodes(out); strings.HasPrefix(out, "Unknown") {
+		return "", nil
+	}
+	return out, nil
+}
+
+func parseFloatPtr(s string) (*float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
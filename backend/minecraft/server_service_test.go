@@ -0,0 +1,191 @@
+package minecraft
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+)
+
+// Output of commands that a server does not support, on 1.13+ vanilla
+// servers, older vanilla servers, and Bukkit-based servers.
+func vanillaUnknown(cmd string) string {
+	return "Unknown or incomplete command, see below for error" + cmd + "<--[HERE]"
+}
+
+func legacyUnknown(string) string {
+	return "Unknown command. Try /help for a list of commands"
+}
+
+func bukkitUnknown(string) string {
+	return `Unknown command. Type "/help" for help.`
+}
+
+func TestServerService(t *testing.T) {
+	ptr := func(f float64) *float64 { return &f }
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		responses map[string]string // by command; others are unknown
+		unknown   func(cmd string) string
+		want      Server
+	}{
+		{
+			name: "vanilla 1.16",
+			responses: map[string]string{
+				"list": "There are 2 of a max of 20 players online: Steve, Alex",
+			},
+			unknown: vanillaUnknown,
+			want:    Server{OnlinePlayers: 2, MaxPlayers: 20},
+		},
+		{
+			name: "vanilla 1.16 (empty)",
+			responses: map[string]string{
+				"list": "There are 0 of a max of 10 players online: ",
+			},
+			unknown: vanillaUnknown,
+			want:    Server{OnlinePlayers: 0, MaxPlayers: 10},
+		},
+		{
+			name: "vanilla 1.12",
+			responses: map[string]string{
+				"list": "There are 1/20 players online:Steve",
+			},
+			unknown: legacyUnknown,
+			want:    Server{OnlinePlayers: 1, MaxPlayers: 20},
+		},
+		{
+			name: "paper 1.16",
+			responses: map[string]string{
+				"list": "There are 3 of a max of 50 players online: Steve, Alex, Notch",
+				"version": "§fThis server is running Paper version git-Paper-445 " +
+					"(MC: 1.16.4) (Implementing API version 1.16.4-R0.1-SNAPSHOT)\n" +
+					"§fYou are running the latest version",
+				"tps": "§6TPS from last 1m, 5m, 15m: §a*20.0, §a19.98, §a19.97",
+			},
+			unknown: bukkitUnknown,
+			want: Server{
+				Version:       str("1.16.4"),
+				OnlinePlayers: 3,
+				MaxPlayers:    50,
+				TPS:           ptr(20),
+			},
+		},
+		{
+			name: "spigot 1.15 (lagging)",
+			responses: map[string]string{
+				"list":    "§6There are §c1§6 out of maximum §c20§6 players online.",
+				"version": "This server is running CraftBukkit version git-Spigot-800b93f-8160e29 (MC: 1.15.2) (Implementing API version 1.15.2-R0.1-SNAPSHOT)",
+				"tps":     "§6TPS from last 1m, 5m, 15m: §e17.52, §a19.1, §a19.9",
+			},
+			unknown: bukkitUnknown,
+			want: Server{
+				Version:       str("1.15.2"),
+				OnlinePlayers: 1,
+				MaxPlayers:    20,
+				TPS:           ptr(17.52),
+			},
+		},
+		{
+			name: "forge 1.16",
+			responses: map[string]string{
+				"list": "There are 1 of a max of 20 players online: Steve",
+				"forge tps": "Dim minecraft:overworld (minecraft:overworld): " +
+					"Mean tick time: 2.123 ms. Mean TPS: 20.000\n" +
+					"Dim minecraft:the_nether (minecraft:the_nether): " +
+					"Mean tick time: 0.051 ms. Mean TPS: 20.000\n" +
+					"Overall: Mean tick time: 2.456 ms. Mean TPS: 20.000",
+			},
+			unknown: vanillaUnknown,
+			want: Server{
+				OnlinePlayers: 1,
+				MaxPlayers:    20,
+				TickTime:      ptr(2.456),
+				TPS:           ptr(20),
+			},
+		},
+		{
+			name: "forge 1.12 (lagging)",
+			responses: map[string]string{
+				"list": "There are 4/20 players online:Steve, Alex, Notch, jeb_",
+				"forge tps": "Dim   0 : Mean tick time: 81.005 ms. Mean TPS: 12.345\n" +
+					"Overall : Mean tick time: 81.005 ms. Mean TPS: 12.345",
+			},
+			unknown: legacyUnknown,
+			want: Server{
+				OnlinePlayers: 4,
+				MaxPlayers:    20,
+				TickTime:      ptr(81.005),
+				TPS:           ptr(12.345),
+			},
+		},
+		{
+			name: "forge 1.18",
+			responses: map[string]string{
+				"list": "There are 0 of a max of 20 players online: ",
+				"forge tps": "Dim minecraft:overworld (minecraft:overworld): 20.000 TPS (1.234 ms/tick)\n" +
+					"Overall: 20.000 TPS (1.234 ms/tick)",
+			},
+			unknown: vanillaUnknown,
+			want: Server{
+				OnlinePlayers: 0,
+				MaxPlayers:    20,
+				TickTime:      ptr(1.234),
+				TPS:           ptr(20),
+			},
+		},
+		{
+			name: "unrecognized optional output",
+			responses: map[string]string{
+				"list":    "There are 0 of a max of 20 players online: ",
+				"version": "Checking version, please wait...",
+				"tps":     "TPS: great",
+			},
+			unknown: bukkitUnknown,
+			want:    Server{OnlinePlayers: 0, MaxPlayers: 20},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			srv := newStubServer(t, func(cmd string) string {
+				if out, ok := test.responses[cmd]; ok {
+					return out
+				}
+				return test.unknown(cmd)
+			})
+			c := NewClient(srv.Addr(), stubPassword)
+			defer c.Close()
+
+			svc := NewServerService(c, log.NewNopLogger())
+			server, err := svc.Get(context.Background())
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			if !reflect.DeepEqual(server, &test.want) {
+				got, _ := json.Marshal(server)
+				want, _ := json.Marshal(&test.want)
+				t.Errorf("expected server %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestServerServiceUnexpectedList(t *testing.T) {
+	srv := newStubServer(t, func(cmd string) string {
+		if cmd == "list" {
+			return "Players: Steve, Alex"
+		}
+		return bukkitUnknown(cmd)
+	})
+	c := NewClient(srv.Addr(), stubPassword)
+	defer c.Close()
+
+	svc := NewServerService(c, log.NewNopLogger())
+	if _, err := svc.Get(context.Background()); err == nil {
+		t.Fatal("expected an error for unrecognized list output")
+	}
+}
@@ -0,0 +1,13 @@
+package sim
+
+import (
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/rconserver"
+)
+
+// NewServer creates a server that serves w over RCON, in the same way that a
+// vanilla server does, to clients that authenticate with password.
+func NewServer(w *World, password string, logger log.Logger) *rconserver.Server {
+	return rconserver.New(w, password, logger)
+}
@@ -0,0 +1,356 @@
+// Package sim simulates a Minecraft server, for local development and for
+// testing without a real server.
+//
+// A World answers commands with the same output that a vanilla server would
+// produce, and a Server exposes it over RCON, so that the rest of the backend
+// can use it in place of a real server.
+package sim
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/presence/scripted"
+)
+
+// A World is a simulated Minecraft world, whose players are played by
+// scripted.Actors.
+//
+// The ID of each actor is used as the player's username, and its Space as the
+// player's dimension. Players that are teleported stop following their
+// scripts, and stand where they were teleported to.
+type World struct {
+	players    *scripted.Provider
+	maxPlayers int
+
+	mu        sync.Mutex
+	teleports map[string]*presence.Entity // by username
+}
+
+// Config configures a World.
+type Config struct {
+	// Now returns the current time, and is passed through to the underlying
+	// scripted.Provider.
+	Now func() time.Time
+
+	// MaxPlayers is the maximum number of players that the World reports.
+	MaxPlayers int
+}
+
+// New creates a World populated by players.
+func New(players []scripted.Actor, opts ...func(*Config)) *World {
+	cfg := Config{
+		Now:        time.Now,
+		MaxPlayers: 20,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &World{
+		players: scripted.New(players, func(sc *scripted.Config) {
+			sc.Now = cfg.Now
+		}),
+		maxPlayers: cfg.MaxPlayers,
+		teleports:  make(map[string]*presence.Entity),
+	}
+}
+
+// Execute executes a command, and returns its output.
+//
+// Only the commands used to read and move players are supported: "list",
+// "data get entity", and "tp". Other commands fail as unknown commands.
+func (w *World) Execute(cmd string) string {
+	args := strings.Fields(cmd)
+	switch {
+	case len(args) == 1 && args[0] == "list":
+		return w.list()
+	case len(args) >= 3 && (args[0] == "tp" || args[0] == "teleport"):
+		return w.teleport(cmd, args[1], args[2:])
+	case len(args) >= 4 && args[0] == "data" && args[1] == "get" &&
+		args[2] == "entity":
+		switch len(args) {
+		case 4:
+			return w.dataGetEntity(args[3], "")
+		case 5:
+			return w.dataGetEntity(args[3], args[4])
+		}
+		return "Incorrect argument for command" + cmd + "<--[HERE]"
+	default:
+		// The server's error is followed by the position of the error within
+		// the command.
+		return "Unknown or incomplete command, see below for error" +
+			cmd + "<--[HERE]"
+	}
+}
+
+// get returns the player with the given username, accounting for teleports.
+func (w *World) get(username string) (*presence.Entity, error) {
+	e, err := w.players.Get(context.Background(), username)
+	if err != nil {
+		return nil, err
+	}
+	return w.teleported(e), nil
+}
+
+// teleported returns where the player represented by e was last teleported to,
+// or e if it was never teleported.
+func (w *World) teleported(e *presence.Entity) *presence.Entity {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t, ok := w.teleports[e.ID]; ok {
+		moved := *t
+		return &moved
+	}
+	return e
+}
+
+func (w *World) list() string {
+	entities, _ := w.players.List(context.Background())
+	usernames := make([]string, len(entities))
+	for i, e := range entities {
+		usernames[i] = e.ID
+	}
+	return fmt.Sprintf(
+		"There are %d of a max of %d players online: %s",
+		len(usernames), w.maxPlayers, strings.Join(usernames, ", "),
+	)
+}
+
+func (w *World) dataGetEntity(target, path string) string {
+	// Selectors are not supported, and would otherwise be resolved to
+	// entities by the server.
+	e, err := w.get(target)
+	if err != nil {
+		return "No entity was found"
+	}
+
+	tags := entityData(e)
+	if path == "" {
+		return e.ID + " has the following entity data: " + formatCompound(tags)
+	}
+
+	// Only paths that name a top-level tag are supported.
+	for _, t := range tags {
+		if t.Key == path {
+			return e.ID + " has the following entity data: " + t.Value
+		}
+	}
+	return "Found no elements matching " + path
+}
+
+// maxCoordinate is the maximum absolute value of the horizontal coordinates
+// that players can be teleported to.
+const maxCoordinate = 30000000
+
+// teleport moves the player target to the position (and optionally rotation)
+// in args, or to the position and rotation of another player.
+func (w *World) teleport(cmd, target string, args []string) string {
+	e, err := w.get(target)
+	if err != nil {
+		return "No entity was found"
+	}
+
+	switch len(args) {
+	case 1:
+		dest, err := w.get(args[0])
+		if err != nil {
+			return "No entity was found"
+		}
+		e.Space = dest.Space
+		e.Position = dest.Position
+		e.Orientation = dest.Orientation
+		w.setTeleported(e)
+		return fmt.Sprintf("Teleported %s to %s", e.ID, dest.ID)
+	case 3, 5:
+		// Relative coordinates (i.e. "~") are not supported, since the
+		// backend never sends them.
+		v := make([]float64, len(args))
+		for i, a := range args {
+			if v[i], err = strconv.ParseFloat(a, 64); err != nil {
+				return "Incorrect argument for command" + cmd + "<--[HERE]"
+			}
+		}
+		if math.Abs(v[0]) > maxCoordinate || math.Abs(v[2]) > maxCoordinate {
+			return "Invalid position for teleport"
+		}
+		e.Position = presence.Position{X: v[0], Y: v[1], Z: v[2]}
+		if len(v) == 5 {
+			e.Orientation = presence.Orientation{
+				Yaw:   float32(v[3]),
+				Pitch: float32(v[4]),
+			}
+		}
+		w.setTeleported(e)
+		return fmt.Sprintf(
+			"Teleported %s to %f, %f, %f",
+			e.ID, e.Position.X, e.Position.Y, e.Position.Z,
+		)
+	default:
+		return "Incorrect argument for command" + cmd + "<--[HERE]"
+	}
+}
+
+func (w *World) setTeleported(e *presence.Entity) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.teleports[e.ID] = e
+}
+
+// A tag is a named tag in an NBT compound, whose value is formatted as SNBT.
+type tag struct{ Key, Value string }
+
+// entityData returns the entity data of the player represented by e, in the
+// order that vanilla servers print them.
+//
+// Only a representative subset of the tags of a real player are included.
+func entityData(e *presence.Entity) []tag {
+	var (
+		pos    = e.Position
+		orient = e.Orientation
+	)
+	return []tag{
+		{"Brain", "{memories: {}}"},
+		{"HurtByTimestamp", "0"},
+		{"SleepTimer", "0s"},
+		{"Attributes", `[{Base: 0.10000000149011612d, Name: "minecraft:generic.movement_speed"}]`},
+		{"Invulnerable", "0b"},
+		{"FallFlying", "0b"},
+		{"PortalCooldown", "0"},
+		{"AbsorptionAmount", "0.0f"},
+		{"abilities", "{invulnerable: 0b, mayfly: 0b, instabuild: 0b, walkSpeed: 0.1f, mayBuild: 1b, flying: 0b, flySpeed: 0.05f}"},
+		{"FallDistance", "0.0f"},
+		{"DeathTime", "0s"},
+		{"XpSeed", "0"},
+		{"XpTotal", "0"},
+		{"UUID", formatUUID(offlineUUID(e.ID))},
+		{"playerGameType", "0"},
+		{"seenCredits", "0b"},
+		{"Motion", "[0.0d, -0.0784000015258789d, 0.0d]"},
+		{"Health", "20.0f"},
+		{"foodSaturationLevel", "5.0f"},
+		{"Air", "300s"},
+		{"OnGround", "1b"},
+		{"Dimension", strconv.Quote(e.Space)},
+		{"Rotation", formatList(
+			formatFloat(float64(orient.Yaw), 32),
+			formatFloat(float64(orient.Pitch), 32),
+		)},
+		{"XpLevel", "0"},
+		{"Score", "0"},
+		{"Pos", formatList(
+			formatFloat(pos.X, 64),
+			formatFloat(pos.Y, 64),
+			formatFloat(pos.Z, 64),
+		)},
+		{"previousPlayerGameType", "-1"},
+		{"Fire", "-20s"},
+		{"XpP", "0.0f"},
+		{"EnderItems", "[]"},
+		{"DataVersion", "2586"},
+		{"foodLevel", "20"},
+		{"foodExhaustionLevel", "0.0f"},
+		{"HurtTime", "0s"},
+		{"SelectedItemSlot", "0"},
+		{"Inventory", "[]"},
+		{"foodTickTimer", "0"},
+	}
+}
+
+func formatCompound(tags []tag) string {
+	parts := make([]string, len(tags))
+	for i, t := range tags {
+		parts[i] = t.Key + ": " + t.Value
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func formatList(values ...string) string {
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// formatFloat formats a float or double (depending on bitSize) like Java does,
+// with a trailing type suffix.
+func formatFloat(v float64, bitSize int) string {
+	s := strconv.FormatFloat(v, 'f', -1, bitSize)
+	if !strings.ContainsRune(s, '.') {
+		s += ".0"
+	}
+	if bitSize == 32 {
+		return s + "f"
+	}
+	return s + "d"
+}
+
+// offlineUUID returns the UUID that a server in offline mode assigns to the
+// player with the given username.
+func offlineUUID(username string) [16]byte {
+	uuid := md5.Sum([]byte("OfflinePlayer:" + username))
+	uuid[6] = (uuid[6] & 0x0f) | 0x30 // version 3
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // IETF variant
+	return uuid
+}
+
+// formatUUID formats uuid as an array of 4 ints, as it is stored in entity
+// data on 1.16+ servers.
+func formatUUID(uuid [16]byte) string {
+	parts := make([]string, 4)
+	for i := range parts {
+		v := int32(binary.BigEndian.Uint32(uuid[4*i:]))
+		parts[i] = strconv.FormatInt(int64(v), 10)
+	}
+	return "[I; " + strings.Join(parts, ", ") + "]"
+}
+
+// Demo returns n scripted.Actors for simulated worlds, which walk around spawn
+// along circles and paths, or stand and look around.
+//
+// Actors arrive 5 seconds apart, so that joins can be observed.
+func Demo(n int) []scripted.Actor {
+	names := []string{"Steve", "Alex"}
+	actors := make([]scripted.Actor, n)
+	for i := range actors {
+		username := fmt.Sprintf("Player%d", i+1)
+		if i < len(names) {
+			username = names[i]
+		}
+
+		var (
+			offset = float64(4 * i)
+			script scripted.Script
+		)
+		switch i % 3 {
+		case 0:
+			center := presence.Position{Y: 64}
+			period := time.Duration(20+10*i) * time.Second
+			script = scripted.Circle(center, 6+offset, period)
+		case 1:
+			d := 8 + offset
+			script = scripted.Path(
+				4.3, // walking speed, in blocks per second
+				presence.Position{X: -d, Y: 64, Z: -d},
+				presence.Position{X: d, Y: 64, Z: -d},
+				presence.Position{X: d, Y: 64, Z: d},
+				presence.Position{X: -d, Y: 64, Z: d},
+			)
+		case 2:
+			pos := presence.Position{X: offset, Y: 64, Z: -offset}
+			script = scripted.Turn(pos, 15*time.Second)
+		}
+		actors[i] = scripted.Actor{
+			ID:     username,
+			Space:  minecraft.DimensionOverworld.String(),
+			Script: script,
+			Arrive: time.Duration(i) * 5 * time.Second,
+		}
+	}
+	return actors
+}
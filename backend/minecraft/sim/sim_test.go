@@ -0,0 +1,93 @@
+package sim
+
+import (
+	"strings"
+	"testing"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/presence/scripted"
+)
+
+func TestTeleport(t *testing.T) {
+	w := New([]scripted.Actor{
+		{
+			ID:     "Steve",
+			Space:  minecraft.DimensionOverworld.String(),
+			Script: scripted.Still(presence.Position{Y: 64}, presence.Orientation{}),
+		},
+		{
+			ID:     "Alex",
+			Space:  minecraft.DimensionEnd.String(),
+			Script: scripted.Still(presence.Position{X: 8, Y: 70}, presence.Orientation{Yaw: 90}),
+		},
+	})
+
+	// Each command is executed in order, and its output is checked, followed
+	// by the player data that it affects.
+	tests := []struct {
+		cmd, out string
+		pos      string
+		rot      string
+		dim      string
+	}{
+		{
+			cmd: "tp Steve 1.5 65 -3",
+			out: "Teleported Steve to 1.500000, 65.000000, -3.000000",
+			pos: "[1.5d, 65.0d, -3.0d]", rot: "[0.0f, 0.0f]",
+			dim: `"minecraft:overworld"`,
+		},
+		{
+			cmd: "tp Steve 2 66 -4 -90 45",
+			out: "Teleported Steve to 2.000000, 66.000000, -4.000000",
+			pos: "[2.0d, 66.0d, -4.0d]", rot: "[-90.0f, 45.0f]",
+			dim: `"minecraft:overworld"`,
+		},
+		{
+			cmd: "tp Steve Alex",
+			out: "Teleported Steve to Alex",
+			pos: "[8.0d, 70.0d, 0.0d]", rot: "[90.0f, 0.0f]",
+			dim: `"minecraft:the_end"`,
+		},
+		{
+			cmd: "tp Steve 30000001 64 0",
+			out: "Invalid position for teleport",
+			pos: "[8.0d, 70.0d, 0.0d]", rot: "[90.0f, 0.0f]",
+			dim: `"minecraft:the_end"`,
+		},
+		{
+			cmd: "tp Steve Notch",
+			out: "No entity was found",
+			pos: "[8.0d, 70.0d, 0.0d]", rot: "[90.0f, 0.0f]",
+			dim: `"minecraft:the_end"`,
+		},
+		{
+			cmd: "tp Steve ~ ~1 ~",
+			out: "Incorrect argument for command",
+			pos: "[8.0d, 70.0d, 0.0d]", rot: "[90.0f, 0.0f]",
+			dim: `"minecraft:the_end"`,
+		},
+	}
+	for _, tt := range tests {
+		if out := w.Execute(tt.cmd); !strings.HasPrefix(out, tt.out) {
+			t.Errorf("%q: output = %q, want %q", tt.cmd, out, tt.out)
+		}
+		for path, want := range map[string]string{
+			"Pos":       tt.pos,
+			"Rotation":  tt.rot,
+			"Dimension": tt.dim,
+		} {
+			want = "Steve has the following entity data: " + want
+			if got := w.Execute("data get entity Steve " + path); got != want {
+				t.Errorf("after %q: %s = %q, want %q", tt.cmd, path, got, want)
+			}
+		}
+	}
+
+	// Teleporting a player does not move the player that it was teleported
+	// to.
+	want := "Alex has the following entity data: [8.0d, 70.0d, 0.0d]"
+	if got := w.Execute("data get entity Alex Pos"); got != want {
+		t.Errorf("Alex: Pos = %q, want %q", got, want)
+	}
+}
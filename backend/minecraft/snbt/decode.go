@@ -0,0 +1,195 @@
+package snbt
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Unmarshal parses the SNBT-encoded data and stores the result in the value
+// pointed to by v.
+//
+// Compounds are decoded into structs or string-keyed maps, and lists and
+// arrays into slices or Go arrays of matching length. Numeric tags may be
+// decoded into any numeric type that can represent them. Struct fields are
+// matched by their `nbt` tag, or by their name if they have none; a tag of "-"
+// skips the field. Tags without a corresponding field are ignored.
+func Unmarshal(data string, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Newf("snbt: Unmarshal requires a non-nil pointer, got %T", v)
+	}
+	value, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	return Decode(value, v)
+}
+
+// Decode stores a parsed SNBT value (as returned by Parse) in the value
+// pointed to by v, following the same rules as Unmarshal.
+func Decode(value interface{}, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Newf("snbt: Decode requires a non-nil pointer, got %T", v)
+	}
+	return decode(value, rv.Elem())
+}
+
+func decode(src interface{}, dst reflect.Value) error {
+	switch dst.Kind() {
+	case reflect.Ptr:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return decode(src, dst.Elem())
+
+	case reflect.Interface:
+		if dst.NumMethod() != 0 {
+			break
+		}
+		dst.Set(reflect.ValueOf(src))
+		return nil
+
+	case reflect.Struct:
+		c, ok := src.(Compound)
+		if !ok {
+			break
+		}
+		return decodeStruct(c, dst)
+
+	case reflect.Map:
+		c, ok := src.(Compound)
+		if !ok || dst.Type().Key().Kind() != reflect.String {
+			break
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), len(c))
+		for k, v := range c {
+			elem := reflect.New(dst.Type().Elem()).Elem()
+			if err := decode(v, elem); err != nil {
+				return errors.Wrapf(err, "key '%s'", k)
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(dst.Type().Key()), elem)
+		}
+		dst.Set(m)
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		sv := reflect.ValueOf(src)
+		if src == nil || sv.Kind() != reflect.Slice {
+			break
+		}
+		n := sv.Len()
+		if dst.Kind() == reflect.Array {
+			if dst.Len() != n {
+				return errors.Newf(
+					"snbt: cannot decode %d elements into %s",
+					n, dst.Type(),
+				)
+			}
+		} else {
+			dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+		}
+		for i := 0; i < n; i++ {
+			if err := decode(sv.Index(i).Interface(), dst.Index(i)); err != nil {
+				return errors.Wrapf(err, "index %d", i)
+			}
+		}
+		return nil
+
+	case reflect.String:
+		s, ok := src.(string)
+		if !ok {
+			break
+		}
+		dst.SetString(s)
+		return nil
+
+	case reflect.Bool:
+		n, ok := toInt(src)
+		if !ok {
+			break
+		}
+		dst.SetBool(n != 0)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := toInt(src)
+		if !ok || dst.OverflowInt(n) {
+			break
+		}
+		dst.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		n, ok := toInt(src)
+		if !ok || n < 0 || dst.OverflowUint(uint64(n)) {
+			break
+		}
+		dst.SetUint(uint64(n))
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch v := src.(type) {
+		case float32:
+			f = float64(v)
+		case float64:
+			f = v
+		default:
+			n, ok := toInt(src)
+			if !ok {
+				return errors.Newf("snbt: cannot decode %T into %s", src, dst.Type())
+			}
+			f = float64(n)
+		}
+		dst.SetFloat(f)
+		return nil
+	}
+	return errors.Newf("snbt: cannot decode %T into %s", src, dst.Type())
+}
+
+func decodeStruct(c Compound, dst reflect.Value) error {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("nbt"); ok {
+			if tag = strings.Split(tag, ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+		}
+
+		v, ok := c[name]
+		if !ok {
+			continue
+		}
+		if err := decode(v, dst.Field(i)); err != nil {
+			return errors.Wrapf(err, "field '%s'", name)
+		}
+	}
+	return nil
+}
+
+func toInt(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,47 @@
+//go:build go1.18
+// +build go1.18
+
+package snbt
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// FuzzParse checks that Parse never panics, only ever fails with a
+// SyntaxError, and that the values it parses survive being formatted and
+// parsed again.
+//
+// It is seeded with real server output, whose size makes minimizing new
+// inputs slow; run it with a short -fuzzminimizetime (i.e. 1s).
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		golden115, golden116, goldenArrays,
+		`{}`, `[]`, `[I;]`, `"a\"b"`, `'it''s'`, `-1.5e3d`, `12b`, `true`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data string) {
+		v, err := Parse(data)
+		if err != nil {
+			var serr *SyntaxError
+			if !errors.As(err, &serr) {
+				t.Fatalf("expected SyntaxError, got %v", err)
+			}
+			return
+		}
+
+		formatted := format(rand.New(rand.NewSource(1)), v)
+		got, err := Parse(formatted)
+		if err != nil {
+			t.Fatalf("parse formatted %s: %v", formatted, err)
+		}
+		if !reflect.DeepEqual(got, v) &&
+			fmt.Sprintf("%#v", got) != fmt.Sprintf("%#v", v) { // NaN != NaN
+			t.Fatalf("%s: expected %#v, got %#v", formatted, v, got)
+		}
+	})
+}
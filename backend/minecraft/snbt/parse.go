@@ -0,0 +1,347 @@
+package snbt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a single SNBT value from s.
+//
+// Leading and trailing whitespace is ignored; any other trailing data is an
+// error.
+func Parse(s string) (interface{}, error) {
+	p := parser{data: s}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.data) {
+		return nil, p.errorf("unexpected trailing data")
+	}
+	return v, nil
+}
+
+type parser struct {
+	data string
+	pos  int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: p.pos, msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next non-whitespace byte, or 0 at the end of input.
+func (p *parser) peek() byte {
+	if p.skipSpace(); p.pos < len(p.data) {
+		return p.data[p.pos]
+	}
+	return 0
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos >= len(p.data) {
+			return p.errorf("expected '%c', got end of input", c)
+		}
+		return p.errorf("expected '%c', got '%c'", c, p.data[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) value() (interface{}, error) {
+	switch c := p.peek(); c {
+	case 0:
+		return nil, p.errorf("unexpected end of input")
+	case '{':
+		return p.compound()
+	case '[':
+		return p.list()
+	case '"', '\'':
+		return p.quoted()
+	default:
+		tok := p.unquoted()
+		if tok == "" {
+			return nil, p.errorf("unexpected character '%c'", c)
+		}
+		return parseScalar(tok), nil
+	}
+}
+
+func (p *parser) compound() (Compound, error) {
+	p.pos++ // consume '{'
+	c := make(Compound)
+	if p.peek() == '}' {
+		p.pos++
+		return c, nil
+	}
+	for {
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(':'); err != nil {
+			return nil, err
+		}
+		if c[key], err = p.value(); err != nil {
+			return nil, err
+		}
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return c, nil
+		default:
+			return nil, p.errorf("expected ',' or '}' in compound")
+		}
+	}
+}
+
+func (p *parser) key() (string, error) {
+	switch p.peek() {
+	case '"', '\'':
+		return p.quoted()
+	default:
+		key := p.unquoted()
+		if key == "" {
+			return "", p.errorf("expected compound key")
+		}
+		return key, nil
+	}
+}
+
+func (p *parser) list() (interface{}, error) {
+	p.pos++ // consume '['
+
+	// Check for a typed array prefix, i.e. "[I; ...]".
+	if p.skipSpace(); p.pos+1 < len(p.data) && p.data[p.pos+1] == ';' {
+		kind := p.data[p.pos]
+		p.pos += 2
+		return p.array(kind)
+	}
+
+	list := make(List, 0)
+	if p.peek() == ']' {
+		p.pos++
+		return list, nil
+	}
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return list, nil
+		default:
+			return nil, p.errorf("expected ',' or ']' in list")
+		}
+	}
+}
+
+func (p *parser) array(kind byte) (interface{}, error) {
+	var min, max int64
+	switch kind {
+	case 'B':
+		min, max = math.MinInt8, math.MaxInt8
+	case 'I':
+		min, max = math.MinInt32, math.MaxInt32
+	case 'L':
+		min, max = math.MinInt64, math.MaxInt64
+	default:
+		p.pos--
+		return nil, p.errorf("unknown array type '%c'", kind)
+	}
+
+	elems := make([]int64, 0)
+	if p.peek() == ']' {
+		p.pos++
+		return makeArray(kind, elems), nil
+	}
+	for {
+		start := p.pos
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+
+		var n int64
+		switch v := v.(type) {
+		case int8:
+			n = int64(v)
+		case int16:
+			n = int64(v)
+		case int32:
+			n = int64(v)
+		case int64:
+			if kind != 'L' {
+				p.pos = start
+				return nil, p.errorf("long in non-long array")
+			}
+			n = v
+		default:
+			p.pos = start
+			return nil, p.errorf("non-integer value in array")
+		}
+		if n < min || n > max {
+			p.pos = start
+			return nil, p.errorf("value out of range for array")
+		}
+		elems = append(elems, n)
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return makeArray(kind, elems), nil
+		default:
+			return nil, p.errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+func makeArray(kind byte, elems []int64) interface{} {
+	switch kind {
+	case 'B':
+		arr := make([]int8, len(elems))
+		for i, n := range elems {
+			arr[i] = int8(n)
+		}
+		return arr
+	case 'I':
+		arr := make([]int32, len(elems))
+		for i, n := range elems {
+			arr[i] = int32(n)
+		}
+		return arr
+	default:
+		return elems
+	}
+}
+
+func (p *parser) quoted() (string, error) {
+	quote := p.data[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.pos >= len(p.data) {
+				return "", p.errorf("unterminated escape sequence")
+			}
+			b.WriteByte(p.data[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// unquoted reads an unquoted token.
+func (p *parser) unquoted() string {
+	start := p.pos
+	for p.pos < len(p.data) && isUnquotedChar(p.data[p.pos]) {
+		p.pos++
+	}
+	return p.data[start:p.pos]
+}
+
+func isUnquotedChar(c byte) bool {
+	return (c >= '0' && c <= '9') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		c == '_' || c == '-' || c == '.' || c == '+'
+}
+
+// parseScalar interprets an unquoted token as a number, boolean, or string.
+//
+// Like the game itself, tokens that look numeric but fail to parse (i.e.
+// "1.5b", or "300b" which overflows a byte) are treated as strings.
+func parseScalar(tok string) interface{} {
+	switch tok {
+	case "true":
+		return int8(1)
+	case "false":
+		return int8(0)
+	}
+	if !looksNumeric(tok) {
+		return tok
+	}
+
+	var (
+		body = tok[:len(tok)-1]
+		v    interface{}
+		err  error
+	)
+	switch tok[len(tok)-1] {
+	case 'b', 'B':
+		var n int64
+		n, err = strconv.ParseInt(body, 10, 8)
+		v = int8(n)
+	case 's', 'S':
+		var n int64
+		n, err = strconv.ParseInt(body, 10, 16)
+		v = int16(n)
+	case 'l', 'L':
+		v, err = strconv.ParseInt(body, 10, 64)
+	case 'f', 'F':
+		var f float64
+		f, err = strconv.ParseFloat(body, 32)
+		v = float32(f)
+	case 'd', 'D':
+		v, err = strconv.ParseFloat(body, 64)
+	default:
+		// Unsuffixed numbers are ints if integral, and doubles otherwise.
+		var n int64
+		if n, err = strconv.ParseInt(tok, 10, 32); err == nil {
+			return int32(n)
+		}
+		if !strings.ContainsAny(tok, ".eE") {
+			return tok
+		}
+		v, err = strconv.ParseFloat(tok, 64)
+	}
+	if err != nil {
+		return tok
+	}
+	return v
+}
+
+// looksNumeric reports whether tok begins like a number (i.e. "-1", "+.5").
+func looksNumeric(tok string) bool {
+	i := 0
+	if tok[0] == '-' || tok[0] == '+' {
+		i++
+	}
+	if i < len(tok) && tok[i] == '.' {
+		i++
+	}
+	return i < len(tok) && tok[i] >= '0' && tok[i] <= '9'
+}
@@ -0,0 +1,39 @@
+// Package snbt implements a parser for stringified NBT (SNBT), the textual
+// representation of Minecraft's Named Binary Tag format that is printed by
+// commands like `data get entity`.
+//
+// SNBT values are decoded into the following Go types:
+//
+//	compound     Compound (map[string]interface{})
+//	list         List ([]interface{})
+//	byte array   []int8
+//	int array    []int32
+//	long array   []int64
+//	byte         int8 (including `true` and `false`)
+//	short        int16
+//	int          int32
+//	long         int64
+//	float        float32
+//	double       float64
+//	string       string
+package snbt
+
+import "fmt"
+
+type (
+	// A Compound is a set of named tags.
+	Compound = map[string]interface{}
+
+	// A List is an ordered sequence of (unnamed) tags.
+	List = []interface{}
+)
+
+// A SyntaxError describes malformed SNBT.
+type SyntaxError struct {
+	Offset int // byte offset into the input at which the error occurred
+	msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("snbt: %s (at offset %d)", e.msg, e.Offset)
+}
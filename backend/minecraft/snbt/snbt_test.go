@@ -0,0 +1,388 @@
+package snbt
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Entity data printed by `data get entity` on real servers, without the
+// leading "<username> has the following entity data: ".
+const (
+	// A 1.15.2 player in the overworld, holding a renamed sword.
+	golden115 = `{Brain: {memories: {}}, HurtByTimestamp: 0, SleepTimer: 0s, ` +
+		`Attributes: [{Base: 20.0d, Name: "generic.maxHealth"}, ` +
+		`{Base: 0.10000000149011612d, Name: "generic.movementSpeed"}], ` +
+		`Invulnerable: 0b, FallFlying: 0b, PortalCooldown: 0, ` +
+		`AbsorptionAmount: 0.0f, abilities: {invulnerable: 0b, mayfly: 0b, ` +
+		`instabuild: 0b, walkSpeed: 0.1f, mayBuild: 1b, flying: 0b, ` +
+		`flySpeed: 0.05f}, FallDistance: 0.0f, DeathTime: 0s, ` +
+		`XpSeed: -1457018254, XpTotal: 0, playerGameType: 0, seenCredits: 0b, ` +
+		`Motion: [0.0d, -0.0784000015258789d, 0.0d], ` +
+		`UUIDLeast: -5567307373004838210L, UUIDMost: 3922429370523239214L, ` +
+		`Health: 20.0f, foodSaturationLevel: 5.0f, Air: 300s, OnGround: 1b, ` +
+		`Dimension: 0, Rotation: [-179.85114f, 18.749998f], XpLevel: 0, ` +
+		`Score: 0, Pos: [-123.30000001192093d, 63.0d, 245.69999998807907d], ` +
+		`Fire: -20s, XpP: 0.0f, EnderItems: [], DataVersion: 2230, ` +
+		`foodLevel: 20, foodExhaustionLevel: 0.0f, HurtTime: 0s, ` +
+		`SelectedItemSlot: 0, Inventory: [{Slot: 0b, ` +
+		`id: "minecraft:diamond_sword", Count: 1b, tag: {Damage: 0, ` +
+		`display: {Name: '{"text":"Steve\'s \\"Blade\\""}'}}}], ` +
+		`foodTickTimer: 0}`
+
+	// A 1.16.4 player in the nether, with an int array UUID.
+	golden116 = `{Brain: {memories: {}}, HurtByTimestamp: 0, SleepTimer: 0s, ` +
+		`SpawnForced: 0b, Attributes: [{Base: 0.10000000149011612d, ` +
+		`Name: "minecraft:generic.movement_speed"}], Invulnerable: 0b, ` +
+		`FallFlying: 0b, PortalCooldown: 0, AbsorptionAmount: 0.0f, ` +
+		`FallDistance: 0.0f, DeathTime: 0s, XpSeed: 0, XpTotal: 0, ` +
+		`UUID: [I; 937680230, -1326496305, -1817106543, 1640476342], ` +
+		`playerGameType: 1, seenCredits: 0b, ` +
+		`Motion: [-1.2E-4d, -0.0784000015258789d, 3.5E-5d], ` +
+		`Health: 20.0f, foodSaturationLevel: 5.0f, Air: 300s, OnGround: 1b, ` +
+		`Dimension: "minecraft:the_nether", Rotation: [90.0f, -90.0f], ` +
+		`XpLevel: 0, Score: 0, Pos: [-8.5d, 32.0d, -1.0E7d], ` +
+		`previousPlayerGameType: -1, Fire: -20s, XpP: 0.0f, ` +
+		`EnderItems: [], DataVersion: 2584, foodLevel: 20, ` +
+		`foodExhaustionLevel: 0.0f, HurtTime: 0s, SelectedItemSlot: 0, ` +
+		`Inventory: [], foodTickTimer: 0}`
+
+	// A block entity with byte and long arrays, as printed by
+	// `data get block`.
+	goldenArrays = `{Bytes: [B; 1b, -128b, 127b], Longs: [L; -1L, ` +
+		`9223372036854775807L], Empty: [I; ], 'quoted key': "a\\b", ` +
+		`Nested: [[1, 2], [], [{}]]}`
+)
+
+func TestUnmarshalGolden(t *testing.T) {
+	type display struct{ Name string }
+	type itemTag struct {
+		Display display `nbt:"display"`
+	}
+	type item struct {
+		ID    string `nbt:"id"`
+		Count int
+		Tag   itemTag `nbt:"tag"`
+	}
+	type player struct {
+		Pos       [3]float64
+		Rotation  [2]float32
+		Motion    []float64
+		Dimension interface{}
+		UUID      []int32
+		UUIDMost  int64
+		OnGround  bool
+		Fire      int16
+		XpSeed    int32
+		Inventory []item
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want player
+	}{
+		{
+			name: "1.15",
+			data: golden115,
+			want: player{
+				Pos:       [3]float64{-123.30000001192093, 63, 245.69999998807907},
+				Rotation:  [2]float32{-179.85114, 18.749998},
+				Motion:    []float64{0, -0.0784000015258789, 0},
+				Dimension: int32(0),
+				UUIDMost:  3922429370523239214,
+				OnGround:  true,
+				Fire:      -20,
+				XpSeed:    -1457018254,
+				Inventory: []item{{
+					ID:    "minecraft:diamond_sword",
+					Count: 1,
+					Tag: itemTag{
+						Display: display{Name: `{"text":"Steve's \"Blade\""}`},
+					},
+				}},
+			},
+		},
+		{
+			name: "1.16",
+			data: golden116,
+			want: player{
+				Pos:       [3]float64{-8.5, 32, -1e7},
+				Rotation:  [2]float32{90, -90},
+				Motion:    []float64{-1.2e-4, -0.0784000015258789, 3.5e-5},
+				Dimension: "minecraft:the_nether",
+				UUID:      []int32{937680230, -1326496305, -1817106543, 1640476342},
+				OnGround:  true,
+				Fire:      -20,
+				Inventory: []item{},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got player
+			if err := Unmarshal(test.data, &got); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("expected %+v, got %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseArrays(t *testing.T) {
+	got, err := Parse(goldenArrays)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	want := Compound{
+		"Bytes":      []int8{1, -128, 127},
+		"Longs":      []int64{-1, math.MaxInt64},
+		"Empty":      []int32{},
+		"quoted key": `a\b`,
+		"Nested":     List{List{int32(1), int32(2)}, List{}, List{Compound{}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestParseScalars(t *testing.T) {
+	tests := []struct {
+		in   string
+		want interface{}
+	}{
+		{"0b", int8(0)},
+		{"-128b", int8(-128)},
+		{"true", int8(1)},
+		{"-32768s", int16(-32768)},
+		{"-2147483648", int32(math.MinInt32)},
+		{"2147483648", "2147483648"}, // overflows an int, and is not a double
+		{"-9223372036854775808L", int64(math.MinInt64)},
+		{"-0.5f", float32(-0.5)},
+		{"-1.0E-5d", -1e-5},
+		{"1.5", 1.5},
+		{"300b", "300b"}, // overflows a byte
+		{"minecraft:stone", nil},
+		{`"\"quoted\""`, `"quoted"`},
+		{`'it\'s'`, "it's"},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.in)
+		if test.want == nil {
+			if err == nil {
+				t.Errorf("%s: expected error, got %#v", test.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.in, err)
+		} else if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %#v, got %#v", test.in, test.want, got)
+		}
+	}
+}
+
+// TestRoundTrip checks that randomly generated values survive being formatted
+// as SNBT and parsed back.
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		want := randomValue(r, 3)
+		data := format(r, want)
+		got, err := Parse(data)
+		if err != nil {
+			t.Fatalf("parse %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: expected %#v, got %#v", data, want, got)
+		}
+	}
+}
+
+// TestParseCorrupted checks that corrupted real output never causes a panic,
+// and only ever fails with a SyntaxError.
+func TestParseCorrupted(t *testing.T) {
+	var (
+		r      = rand.New(rand.NewSource(1))
+		inputs = []string{golden115, golden116, goldenArrays}
+		chars  = []byte(`{}[]:;,"'\ 0-.BILbsfdL`)
+	)
+	for i := 0; i < 5000; i++ {
+		data := []byte(inputs[r.Intn(len(inputs))])
+		switch r.Intn(3) {
+		case 0: // truncate
+			data = data[:r.Intn(len(data))]
+		case 1: // replace bytes
+			for j := r.Intn(4); j >= 0; j-- {
+				data[r.Intn(len(data))] = chars[r.Intn(len(chars))]
+			}
+		case 2: // delete a span
+			start := r.Intn(len(data))
+			end := start + r.Intn(len(data)-start)
+			data = append(data[:start], data[end:]...)
+		}
+
+		_, err := Parse(string(data))
+		var serr *SyntaxError
+		if err != nil && !errors.As(err, &serr) {
+			t.Fatalf("%s: expected SyntaxError, got %v", data, err)
+		}
+	}
+}
+
+func randomValue(r *rand.Rand, depth int) interface{} {
+	kinds := 12
+	if depth == 0 {
+		kinds = 9 // scalars only
+	}
+	switch r.Intn(kinds) {
+	case 0:
+		return int8(r.Intn(256) - 128)
+	case 1:
+		return int16(r.Intn(65536) - 32768)
+	case 2:
+		return int32(r.Uint32())
+	case 3:
+		return int64(r.Uint64())
+	case 4:
+		return float32(r.NormFloat64() * math.Pow(10, float64(r.Intn(20)-10)))
+	case 5:
+		return r.NormFloat64() * math.Pow(10, float64(r.Intn(40)-20))
+	case 6:
+		return randomString(r)
+	case 7:
+		arr := make([]int8, r.Intn(4))
+		for i := range arr {
+			arr[i] = int8(r.Intn(256) - 128)
+		}
+		return arr
+	case 8:
+		if r.Intn(2) == 0 {
+			arr := make([]int32, r.Intn(4))
+			for i := range arr {
+				arr[i] = int32(r.Uint32())
+			}
+			return arr
+		}
+		arr := make([]int64, r.Intn(4))
+		for i := range arr {
+			arr[i] = int64(r.Uint64())
+		}
+		return arr
+	case 9, 10:
+		c := make(Compound)
+		for i := r.Intn(4); i > 0; i-- {
+			c[randomString(r)] = randomValue(r, depth-1)
+		}
+		return c
+	default:
+		list := make(List, r.Intn(4))
+		for i := range list {
+			list[i] = randomValue(r, depth-1)
+		}
+		return list
+	}
+}
+
+func randomString(r *rand.Rand) string {
+	const alphabet = `abcXYZ019_-.+: "'\{}[],;é☃`
+	runes := []rune(alphabet)
+	var b strings.Builder
+	for i := r.Intn(8); i > 0; i-- {
+		b.WriteRune(runes[r.Intn(len(runes))])
+	}
+	return b.String()
+}
+
+// format formats v as SNBT, choosing randomly between equivalent
+// representations (i.e. of quotes and whitespace).
+func format(r *rand.Rand, v interface{}) string {
+	space := func() string { return strings.Repeat(" ", r.Intn(2)) }
+	join := func(parts []string) string {
+		return strings.Join(parts, ","+space())
+	}
+
+	switch v := v.(type) {
+	case int8:
+		return strconv.Itoa(int(v)) + "bB"[r.Intn(2):][:1]
+	case int16:
+		return strconv.Itoa(int(v)) + "sS"[r.Intn(2):][:1]
+	case int32:
+		return strconv.Itoa(int(v))
+	case int64:
+		return strconv.FormatInt(v, 10) + "lL"[r.Intn(2):][:1]
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32) + "fF"[r.Intn(2):][:1]
+	case float64:
+		return strconv.FormatFloat(v, 'E', -1, 64) + "dD"[r.Intn(2):][:1]
+	case string:
+		return quote(r, v)
+	case []int8:
+		parts := make([]string, len(v))
+		for i, n := range v {
+			parts[i] = format(r, n)
+		}
+		return "[B;" + space() + join(parts) + "]"
+	case []int32:
+		parts := make([]string, len(v))
+		for i, n := range v {
+			parts[i] = format(r, n)
+		}
+		return "[I;" + space() + join(parts) + "]"
+	case []int64:
+		parts := make([]string, len(v))
+		for i, n := range v {
+			parts[i] = format(r, n)
+		}
+		return "[L;" + space() + join(parts) + "]"
+	case Compound:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			key := quote(r, k)
+			if k != "" && strings.IndexFunc(k, func(c rune) bool {
+				return c > 0x7f || !isUnquotedChar(byte(c))
+			}) < 0 && r.Intn(2) == 0 {
+				key = k
+			}
+			parts[i] = key + ":" + space() + format(r, v[k])
+		}
+		return "{" + join(parts) + "}"
+	case List:
+		parts := make([]string, len(v))
+		for i, elem := range v {
+			parts[i] = format(r, elem)
+		}
+		return "[" + join(parts) + "]"
+	default:
+		panic(fmt.Sprintf("unexpected type %T", v))
+	}
+}
+
+// quote quotes s with either kind of quote, like the game does.
+func quote(r *rand.Rand, s string) string {
+	q := `"'`[r.Intn(2)]
+	var b strings.Builder
+	b.WriteByte(q)
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == q || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte(q)
+	return b.String()
+}
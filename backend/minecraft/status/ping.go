@@ -0,0 +1,164 @@
+// Package status implements the protocols that Minecraft servers use to report
+// their status to clients that cannot use RCON: Server List Ping, and the
+// GameSpy4-based Query protocol.
+package status
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+)
+
+// A PingResponse is a server's response to a Server List Ping.
+type PingResponse struct {
+	Version struct {
+		Name     string `json:"name"`
+		Protocol int    `json:"protocol"`
+	} `json:"version"`
+	Players struct {
+		Max    int `json:"max"`
+		Online int `json:"online"`
+
+		// Sample is a subset of the online players, which vanilla servers
+		// limit to 12 players.
+		Sample []PingPlayer `json:"sample"`
+	} `json:"players"`
+	Description text.Component `json:"description"`
+}
+
+// A PingPlayer is a player in a PingResponse.
+type PingPlayer struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// maxPacketLength is the maximum length of a packet that Ping will read.
+//
+// Responses include the server's icon, so they can be fairly large.
+const maxPacketLength = 1 << 20
+
+// Ping performs a Server List Ping against the server at addr (of the form
+// "host:port"), as supported by servers running Minecraft 1.7 and later.
+func Ping(ctx context.Context, addr string) (*PingResponse, error) {
+	host, portText, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: parse address")
+	}
+	port, err := strconv.ParseUint(portText, 10, 16)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: parse port")
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: dial")
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// Send handshake (with next state 1, for status), followed by a status
+	// request.
+	{
+		var handshake bytes.Buffer
+		writeVarInt(&handshake, 0x00) // packet ID
+		writeVarInt(&handshake, -1)   // protocol version, unspecified
+		writeString(&handshake, host)
+		binary.Write(&handshake, binary.BigEndian, uint16(port))
+		writeVarInt(&handshake, 1) // next state
+
+		var req bytes.Buffer
+		writePacket(&req, handshake.Bytes())
+		writePacket(&req, []byte{0x00}) // status request
+		if _, err = conn.Write(req.Bytes()); err != nil {
+			return nil, errors.Wrap(err, "status: write request")
+		}
+	}
+
+	// Read status response.
+	packet, err := readPacket(bufio.NewReader(conn))
+	if err != nil {
+		return nil, errors.Wrap(err, "status: read response")
+	}
+	r := bytes.NewReader(packet)
+	if id, err := binary.ReadUvarint(r); err != nil {
+		return nil, errors.Wrap(err, "status: read packet ID")
+	} else if id != 0x00 {
+		return nil, errors.Newf("status: unexpected packet ID 0x%02x", id)
+	}
+	data, err := readString(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: read response")
+	}
+
+	var res PingResponse
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, errors.Wrap(err, "status: decode response")
+	}
+	return &res, nil
+}
+
+// writeVarInt writes v as a VarInt, which encodes the two's complement of v
+// as an unsigned LEB128.
+func writeVarInt(w *bytes.Buffer, v int32) {
+	var buf [binary.MaxVarintLen32]byte
+	n := binary.PutUvarint(buf[:], uint64(uint32(v)))
+	w.Write(buf[:n])
+}
+
+func writeString(w *bytes.Buffer, s string) {
+	writeVarInt(w, int32(len(s)))
+	w.WriteString(s)
+}
+
+func writePacket(w *bytes.Buffer, packet []byte) {
+	writeVarInt(w, int32(len(packet)))
+	w.Write(packet)
+}
+
+func readLength(r io.ByteReader) (int, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	if n > maxPacketLength {
+		return 0, errors.Newf("status: length %d exceeds limit", n)
+	}
+	return int(n), nil
+}
+
+func readPacket(r *bufio.Reader) ([]byte, error) {
+	n, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+	packet := make([]byte, n)
+	if _, err = io.ReadFull(r, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+func readString(r *bytes.Reader) ([]byte, error) {
+	n, err := readLength(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > r.Len() {
+		return nil, io.ErrUnexpectedEOF
+	}
+	s := make([]byte, n)
+	r.Read(s)
+	return s, nil
+}
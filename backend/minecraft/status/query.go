@@ -0,0 +1,197 @@
+package status
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"net"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A QueryResponse is a server's response to a full stat Query.
+type QueryResponse struct {
+	MOTD       string
+	GameType   string
+	GameID     string
+	Version    string
+	Plugins    string
+	Map        string
+	NumPlayers int
+	MaxPlayers int
+	HostPort   int
+	HostIP     string
+
+	// Players are the usernames of all online players.
+	Players []string
+}
+
+const (
+	queryTypeHandshake byte = 0x09
+	queryTypeStat      byte = 0x00
+)
+
+var queryMagic = []byte{0xFE, 0xFD}
+
+// Query performs a full stat Query against the server at addr (of the form
+// "host:port"), which must have `enable-query` set in its server.properties.
+//
+// Unlike a Server List Ping, a Query lists all online players.
+func Query(ctx context.Context, addr string) (*QueryResponse, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: dial")
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// Session IDs must only use the lower 4 bits of each byte.
+	session := rand.Int31() & 0x0F0F0F0F
+	buf := make([]byte, 1<<16)
+
+	// Request a challenge token.
+	res, err := queryRoundTrip(conn, buf, queryTypeHandshake, session, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: handshake")
+	}
+	tokenText, _, err := readNullTerminated(res)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: read challenge token")
+	}
+	token, err := strconv.ParseInt(tokenText, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "status: parse challenge token")
+	}
+
+	// Request full stat, which is indicated by 4 bytes of padding after the
+	// challenge token.
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint32(payload, uint32(token))
+	if res, err = queryRoundTrip(conn, buf, queryTypeStat, session, payload); err != nil {
+		return nil, errors.Wrap(err, "status: full stat")
+	}
+	return parseFullStat(res)
+}
+
+// queryRoundTrip sends a request of type typ, and returns the payload of the
+// response.
+func queryRoundTrip(
+	conn net.Conn,
+	buf []byte,
+	typ byte,
+	session int32,
+	payload []byte,
+) ([]byte, error) {
+	var req bytes.Buffer
+	req.Write(queryMagic)
+	req.WriteByte(typ)
+	binary.Write(&req, binary.BigEndian, session)
+	req.Write(payload)
+	if _, err := conn.Write(req.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "write request")
+	}
+
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response")
+	}
+	res := buf[:n]
+	if len(res) < 5 || res[0] != typ ||
+		int32(binary.BigEndian.Uint32(res[1:5])) != session {
+		return nil, errors.New("unexpected response")
+	}
+	return res[5:], nil
+}
+
+var (
+	fullStatPadding    = []byte("splitnum\x00\x80\x00")
+	fullStatPlayersKey = []byte("\x01player_\x00\x00")
+)
+
+func parseFullStat(data []byte) (*QueryResponse, error) {
+	if !bytes.HasPrefix(data, fullStatPadding) {
+		return nil, errors.New("status: malformed full stat")
+	}
+	data = data[len(fullStatPadding):]
+
+	// Read key-value section, which ends with an empty key.
+	var (
+		res QueryResponse
+		err error
+	)
+	for {
+		var key, value string
+		if key, data, err = readNullTerminated(data); err != nil {
+			return nil, errors.Wrap(err, "status: read key")
+		}
+		if key == "" {
+			break
+		}
+		if value, data, err = readNullTerminated(data); err != nil {
+			return nil, errors.Wrapf(err, "status: read value of '%s'", key)
+		}
+		switch key {
+		case "hostname":
+			res.MOTD = value
+		case "gametype":
+			res.GameType = value
+		case "game_id":
+			res.GameID = value
+		case "version":
+			res.Version = value
+		case "plugins":
+			res.Plugins = value
+		case "map":
+			res.Map = value
+		case "numplayers":
+			res.NumPlayers, err = strconv.Atoi(value)
+		case "maxplayers":
+			res.MaxPlayers, err = strconv.Atoi(value)
+		case "hostport":
+			res.HostPort, err = strconv.Atoi(value)
+		case "hostip":
+			res.HostIP = value
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "status: parse value of '%s'", key)
+		}
+	}
+
+	// Read players section, which ends with an empty username.
+	if !bytes.HasPrefix(data, fullStatPlayersKey) {
+		return nil, errors.New("status: malformed full stat")
+	}
+	data = data[len(fullStatPlayersKey):]
+	for {
+		var name string
+		if name, data, err = readNullTerminated(data); err != nil {
+			return nil, errors.Wrap(err, "status: read player")
+		}
+		if name == "" {
+			break
+		}
+		res.Players = append(res.Players, name)
+	}
+	return &res, nil
+}
+
+// readNullTerminated reads a null-terminated string from data, and returns
+// the remaining data.
+//
+// Strings are encoded as ISO-8859-1.
+func readNullTerminated(data []byte) (s string, rest []byte, err error) {
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return "", nil, errors.New("unterminated string")
+	}
+	runes := make([]rune, i)
+	for j, b := range data[:i] {
+		runes[j] = rune(b)
+	}
+	return string(runes), data[i+1:], nil
+}
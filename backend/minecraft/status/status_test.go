@@ -0,0 +1,87 @@
+package status_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status/statustest"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+)
+
+func newServer(t *testing.T) (*statustest.Server, status.PingResponse, status.QueryResponse) {
+	t.Helper()
+
+	var ping status.PingResponse
+	ping.Version.Name = "1.16.4"
+	ping.Version.Protocol = 754
+	ping.Players.Max = 20
+	ping.Players.Online = 2
+	ping.Players.Sample = []status.PingPlayer{
+		{Name: "Steve", ID: "8667ba71-b85a-4004-af54-457a9734eed7"},
+		{Name: "Alex", ID: "ec561538-f3fd-461d-aff5-086b22154bce"},
+	}
+	ping.Description = text.Colored("A Minecraft Server", text.Green)
+
+	query := status.QueryResponse{
+		MOTD:       "A Minecraft Server",
+		GameType:   "SMP",
+		GameID:     "MINECRAFT",
+		Version:    "1.16.4",
+		Map:        "world",
+		NumPlayers: 3,
+		MaxPlayers: 20,
+		HostPort:   25565,
+		HostIP:     "127.0.0.1",
+		Players:    []string{"Steve", "Alex", "Zoë"},
+	}
+
+	srv, err := statustest.NewServer(ping, query)
+	if err != nil {
+		t.Fatalf("start server: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	return srv, ping, query
+}
+
+func TestPing(t *testing.T) {
+	srv, want, _ := newServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := status.Ping(ctx, srv.Addr)
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if !reflect.DeepEqual(*res, want) {
+		t.Errorf("Ping = %+v, want %+v", *res, want)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	srv, _, want := newServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := status.Query(ctx, srv.QueryAddr)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if !reflect.DeepEqual(*res, want) {
+		t.Errorf("Query = %+v, want %+v", *res, want)
+	}
+}
+
+func TestPingUnreachable(t *testing.T) {
+	srv, _, _ := newServer(t)
+	addr := srv.Addr
+	srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := status.Ping(ctx, addr); err == nil {
+		t.Error("Ping: expected error after server closed")
+	}
+}
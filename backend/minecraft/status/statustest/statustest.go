@@ -0,0 +1,228 @@
+// Package statustest implements a fake Minecraft server that reports its
+// status through Server List Ping and Query, for use in tests.
+package statustest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status"
+)
+
+// A Server is a fake Minecraft server, which serves Server List Pings on Addr
+// and Queries on QueryAddr (both on the loopback interface).
+type Server struct {
+	Addr      string
+	QueryAddr string
+
+	ping  status.PingResponse
+	query status.QueryResponse
+
+	lis  net.Listener
+	conn net.PacketConn
+	wg   sync.WaitGroup
+}
+
+// challengeToken is the token that the Server issues to Query clients.
+const challengeToken = 9513307
+
+// NewServer starts a Server that responds to Server List Pings with ping, and
+// to Queries with query.
+func NewServer(ping status.PingResponse, query status.QueryResponse) (*Server, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		lis.Close()
+		return nil, err
+	}
+	s := &Server{
+		Addr:      lis.Addr().String(),
+		QueryAddr: conn.LocalAddr().String(),
+		ping:      ping,
+		query:     query,
+		lis:       lis,
+		conn:      conn,
+	}
+	s.wg.Add(2)
+	go s.servePing()
+	go s.serveQuery()
+	return s, nil
+}
+
+// Close stops the Server.
+func (s *Server) Close() {
+	s.lis.Close()
+	s.conn.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) servePing() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.lis.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			s.handlePing(conn)
+		}()
+	}
+}
+
+// handlePing handles a Server List Ping, which consists of a handshake, a
+// status request, and optionally a ping.
+func (s *Server) handlePing(conn net.Conn) {
+	r := bufio.NewReader(conn)
+
+	// Read handshake, which must request the status state (1).
+	packet, err := readPacket(r)
+	if err != nil {
+		return
+	}
+	pr := bytes.NewReader(packet)
+	if id, _ := binary.ReadUvarint(pr); id != 0x00 {
+		return
+	}
+	binary.ReadUvarint(pr) // protocol version
+	if _, err = readString(pr); err != nil {
+		return
+	}
+	var port uint16
+	binary.Read(pr, binary.BigEndian, &port)
+	if state, _ := binary.ReadUvarint(pr); state != 1 {
+		return
+	}
+
+	for {
+		packet, err := readPacket(r)
+		if err != nil || len(packet) == 0 {
+			return
+		}
+		var res bytes.Buffer
+		switch packet[0] {
+		case 0x00: // status request
+			data, _ := json.Marshal(s.ping)
+			writeUvarint(&res, 0x00)
+			writeUvarint(&res, uint64(len(data)))
+			res.Write(data)
+		case 0x01: // ping, whose payload is echoed
+			res.Write(packet)
+		default:
+			return
+		}
+		var out bytes.Buffer
+		writeUvarint(&out, uint64(res.Len()))
+		out.Write(res.Bytes())
+		if _, err = conn.Write(out.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) serveQuery() {
+	defer s.wg.Done()
+	buf := make([]byte, 1<<16)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if res := s.handleQuery(buf[:n]); res != nil {
+			s.conn.WriteTo(res, addr)
+		}
+	}
+}
+
+// handleQuery returns the response to a Query request, or nil if the request
+// is invalid (which servers ignore).
+func (s *Server) handleQuery(req []byte) []byte {
+	if len(req) < 7 || req[0] != 0xFE || req[1] != 0xFD {
+		return nil
+	}
+	var (
+		typ     = req[2]
+		session = req[3:7]
+		payload = req[7:]
+		res     bytes.Buffer
+	)
+	res.WriteByte(typ)
+	res.Write(session)
+	switch typ {
+	case 0x09: // handshake
+		res.WriteString(strconv.Itoa(challengeToken))
+		res.WriteByte(0)
+	case 0x00: // stat, which is a full stat if the token is padded
+		if len(payload) != 8 ||
+			binary.BigEndian.Uint32(payload) != challengeToken {
+			return nil
+		}
+		q := s.query
+		res.WriteString("splitnum\x00\x80\x00")
+		for _, kv := range [][2]string{
+			{"hostname", q.MOTD},
+			{"gametype", q.GameType},
+			{"game_id", q.GameID},
+			{"version", q.Version},
+			{"plugins", q.Plugins},
+			{"map", q.Map},
+			{"numplayers", strconv.Itoa(q.NumPlayers)},
+			{"maxplayers", strconv.Itoa(q.MaxPlayers)},
+			{"hostport", strconv.Itoa(q.HostPort)},
+			{"hostip", q.HostIP},
+		} {
+			writeLatin1(&res, kv[0])
+			writeLatin1(&res, kv[1])
+		}
+		res.WriteString("\x00\x01player_\x00\x00")
+		for _, name := range q.Players {
+			writeLatin1(&res, name)
+		}
+		res.WriteByte(0)
+	default:
+		return nil
+	}
+	return res.Bytes()
+}
+
+// writeLatin1 writes s as a null-terminated ISO-8859-1 string.
+func writeLatin1(w *bytes.Buffer, s string) {
+	for _, r := range s {
+		w.WriteByte(byte(r))
+	}
+	w.WriteByte(0)
+}
+
+func writeUvarint(w *bytes.Buffer, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func readPacket(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	packet := make([]byte, n)
+	_, err = io.ReadFull(r, packet)
+	return packet, err
+}
+
+func readString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	s := make([]byte, n)
+	_, err = io.ReadFull(r, s)
+	return string(s), err
+}
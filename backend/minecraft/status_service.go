@@ -0,0 +1,240 @@
+package minecraft
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// StatusConfig configures the services created by NewStatusPlayerService and
+// NewStatusServerService.
+type StatusConfig struct {
+	// QueryAddress is the address of the server's Query port. If it is
+	// non-empty, the server is queried using the Query protocol instead of
+	// Server List Ping.
+	//
+	// Servers only report a sample of up to 12 players through Server List
+	// Ping, so larger servers should enable the Query protocol (using
+	// `enable-query` in their server.properties).
+	QueryAddress string
+
+	// Timeout is the maximum time to wait for the server to respond.
+	Timeout time.Duration
+}
+
+// statusReader reads the status of a server, for services that cannot access
+// it through RCON.
+type statusReader struct {
+	addr   string
+	cfg    StatusConfig
+	logger log.Logger
+}
+
+func newStatusReader(
+	addr string,
+	logger log.Logger,
+	opts []func(*StatusConfig),
+) statusReader {
+	cfg := StatusConfig{Timeout: 5 * time.Second}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return statusReader{
+		addr:   addr,
+		cfg:    cfg,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+// serverStatus is the status of a server, as read by a statusReader.
+type serverStatus struct {
+	Usernames  []string
+	Version    string
+	MOTD       string
+	Online     int
+	MaxPlayers int
+}
+
+func (r statusReader) read(ctx context.Context) (*serverStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	var (
+		res *serverStatus
+		err error
+	)
+	if r.cfg.QueryAddress != "" {
+		res, err = r.query(ctx)
+	} else {
+		res, err = r.ping(ctx)
+	}
+	if err != nil {
+		err = errors.Mark(err, ErrUnavailable)
+		return nil, exthttp.WrapWithHTTPCode(err, http.StatusServiceUnavailable)
+	}
+	res.MOTD = strings.TrimSpace(text.StripCodes(res.MOTD))
+	return res, nil
+}
+
+func (r statusReader) query(ctx context.Context) (*serverStatus, error) {
+	res, err := status.Query(ctx, r.cfg.QueryAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &serverStatus{
+		Usernames:  res.Players,
+		Version:    res.Version,
+		MOTD:       res.MOTD,
+		Online:     res.NumPlayers,
+		MaxPlayers: res.MaxPlayers,
+	}, nil
+}
+
+// anonymousPlayerID is the ID of the players that servers report in place of
+// real players, when they are configured to hide them.
+const anonymousPlayerID = "00000000-0000-0000-0000-000000000000"
+
+func (r statusReader) ping(ctx context.Context) (*serverStatus, error) {
+	res, err := status.Ping(ctx, r.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers may fill the sample with arbitrary text (i.e. to advertise), so
+	// only keep entries that look like real players.
+	usernames := make([]string, 0, len(res.Players.Sample))
+	for _, p := range res.Players.Sample {
+		if p.ID == anonymousPlayerID || command.ValidateUsername(p.Name) != nil {
+			continue
+		}
+		usernames = append(usernames, p.Name)
+	}
+	if len(usernames) < res.Players.Online {
+		l := log.With(r.logger, "online", res.Players.Online)
+		logutil.Log(l, "status only includes %d players", len(usernames))
+	}
+
+	return &serverStatus{
+		Usernames:  usernames,
+		Version:    res.Version.Name,
+		MOTD:       res.Description.String(),
+		Online:     res.Players.Online,
+		MaxPlayers: res.Players.Max,
+	}, nil
+}
+
+type statusPlayerService struct {
+	reader statusReader
+	logger log.Logger
+}
+
+// NewStatusPlayerService creates a PlayerService that reads players from the
+// status of the server at addr, for servers that do not expose RCON.
+//
+// The status of a server does not include the positions of its players, so
+// the Players that it returns have PositionUnknown set.
+func NewStatusPlayerService(
+	addr string,
+	logger log.Logger,
+	opts ...func(*StatusConfig),
+) PlayerService {
+	return &statusPlayerService{
+		reader: newStatusReader(addr, logger, opts),
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (svc *statusPlayerService) List(ctx context.Context) (_ []*Player, err error) {
+	defer func(start time.Time) {
+		l := log.With(svc.logger, "took", time.Since(start))
+		logutil.Trace(l, "List", err)
+	}(time.Now())
+
+	res, err := svc.reader.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	players := make([]*Player, len(res.Usernames))
+	for i, u := range res.Usernames {
+		players[i] = &Player{Username: u, PositionUnknown: true}
+	}
+	return players, nil
+}
+
+func (svc *statusPlayerService) Get(
+	ctx context.Context,
+	username string,
+) (_ *Player, err error) {
+	logger := log.With(svc.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Get", err)
+	}(time.Now())
+
+	res, err := svc.reader.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range res.Usernames {
+		if u == username {
+			return &Player{Username: u, PositionUnknown: true}, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+type statusServerService struct {
+	reader statusReader
+	logger log.Logger
+}
+
+// NewStatusServerService creates a ServerService that reads the status of the
+// server at addr, for servers that do not expose RCON.
+//
+// The status of a server does not include its tick rate, so the TickTime and
+// TPS of the Servers that it returns are always nil.
+func NewStatusServerService(
+	addr string,
+	logger log.Logger,
+	opts ...func(*StatusConfig),
+) ServerService {
+	return &statusServerService{
+		reader: newStatusReader(addr, logger, opts),
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (svc *statusServerService) Get(ctx context.Context) (_ *Server, err error) {
+	defer func(start time.Time) {
+		l := log.With(svc.logger, "took", time.Since(start))
+		logutil.Trace(l, "Get", err)
+	}(time.Now())
+
+	res, err := svc.reader.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	server := Server{
+		OnlinePlayers: res.Online,
+		MaxPlayers:    res.MaxPlayers,
+	}
+	if res.Version != "" {
+		server.Version = &res.Version
+	}
+	if res.MOTD != "" {
+		server.MOTD = &res.MOTD
+	}
+	return &server, nil
+}
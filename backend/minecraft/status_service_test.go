@@ -0,0 +1,196 @@
+package minecraft
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/status/statustest"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/text"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// newStatusServer starts a fake server whose status lists the given usernames,
+// of which only the first 12 are included in its Server List Ping sample.
+func newStatusServer(t *testing.T, usernames ...string) *statustest.Server {
+	t.Helper()
+
+	var ping status.PingResponse
+	ping.Version.Name = "Paper 1.16.4"
+	ping.Version.Protocol = 754
+	ping.Players.Max = 20
+	ping.Players.Online = len(usernames)
+	ping.Description = text.Plain("§aA §lMinecraft§r Server ")
+	for i, u := range usernames {
+		if i == 12 {
+			break
+		}
+		ping.Players.Sample = append(ping.Players.Sample, status.PingPlayer{
+			Name: u,
+			ID:   "8667ba71-b85a-4004-af54-457a9734eed7",
+		})
+	}
+
+	// Servers may hide players, or fill the sample with other text.
+	ping.Players.Sample = append(ping.Players.Sample,
+		status.PingPlayer{Name: "Anonymous Player", ID: anonymousPlayerID},
+		status.PingPlayer{Name: "§6Visit our store!", ID: anonymousPlayerID},
+		status.PingPlayer{Name: "Join discord", ID: "4566e69f-c907-48ee-8d71-d7ba5aa00d20"},
+	)
+
+	query := status.QueryResponse{
+		MOTD:       "§aA §lMinecraft§r Server ",
+		GameType:   "SMP",
+		GameID:     "MINECRAFT",
+		Version:    "1.16.4",
+		Map:        "world",
+		NumPlayers: len(usernames),
+		MaxPlayers: 20,
+		HostPort:   25565,
+		HostIP:     "127.0.0.1",
+		Players:    usernames,
+	}
+
+	srv, err := statustest.NewServer(ping, query)
+	if err != nil {
+		t.Fatalf("start status server: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestStatusPlayerService(t *testing.T) {
+	usernames := make([]string, 15)
+	for i := range usernames {
+		usernames[i] = "Player" + string(rune('A'+i))
+	}
+	srv := newStatusServer(t, usernames...)
+
+	tests := []struct {
+		name string
+		opts []func(*StatusConfig)
+		want []string
+	}{
+		{name: "Ping", want: usernames[:12]},
+		{
+			name: "Query",
+			opts: []func(*StatusConfig){func(cfg *StatusConfig) {
+				cfg.QueryAddress = srv.QueryAddr
+			}},
+			want: usernames,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewStatusPlayerService(srv.Addr, log.NewNopLogger(), tt.opts...)
+			players, err := svc.List(context.Background())
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			got := make([]string, len(players))
+			for i, p := range players {
+				got[i] = p.Username
+				if !p.PositionUnknown {
+					t.Errorf("player %q: expected PositionUnknown", p.Username)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List = %q, want %q", got, tt.want)
+			}
+
+			p, err := svc.Get(context.Background(), tt.want[0])
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if p.Username != tt.want[0] {
+				t.Errorf("Get = %q, want %q", p.Username, tt.want[0])
+			}
+			if _, err = svc.Get(context.Background(), "Notch"); err != ErrNotFound {
+				t.Errorf("Get(Notch): expected ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+// Players read from a server's status do not have positions, so reading them
+// must fail as unsupported (rather than report them at the origin).
+func TestStatusPlayerPositionUnsupported(t *testing.T) {
+	srv := newStatusServer(t, "Steve")
+	prov := NewProvider(NewStatusPlayerService(srv.Addr, log.NewNopLogger()))
+
+	entity, err := prov.Get(context.Background(), "Steve")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	err = entity.RequirePosition()
+	if !errors.Is(err, presence.ErrUnsupported) {
+		t.Fatalf("RequirePosition: expected ErrUnsupported, got %v", err)
+	}
+	if code := exthttp.GetHTTPCode(err, 0); code != http.StatusNotImplemented {
+		t.Errorf("RequirePosition: expected HTTP code 501, got %d", code)
+	}
+}
+
+func TestStatusServerService(t *testing.T) {
+	srv := newStatusServer(t, "Steve", "Alex")
+
+	tests := []struct {
+		name    string
+		opts    []func(*StatusConfig)
+		version string
+	}{
+		{name: "Ping", version: "Paper 1.16.4"},
+		{
+			name: "Query",
+			opts: []func(*StatusConfig){func(cfg *StatusConfig) {
+				cfg.QueryAddress = srv.QueryAddr
+			}},
+			version: "1.16.4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewStatusServerService(srv.Addr, log.NewNopLogger(), tt.opts...)
+			server, err := svc.Get(context.Background())
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if server.Version == nil || *server.Version != tt.version {
+				t.Errorf("Version = %v, want %q", server.Version, tt.version)
+			}
+			if want := "A Minecraft Server"; server.MOTD == nil || *server.MOTD != want {
+				t.Errorf("MOTD = %v, want %q", server.MOTD, want)
+			}
+			if server.OnlinePlayers != 2 || server.MaxPlayers != 20 {
+				t.Errorf(
+					"players = %d/%d, want 2/20",
+					server.OnlinePlayers, server.MaxPlayers,
+				)
+			}
+			if server.TPS != nil || server.TickTime != nil {
+				t.Error("expected TPS and TickTime to be unknown")
+			}
+		})
+	}
+}
+
+func TestStatusUnavailable(t *testing.T) {
+	srv := newStatusServer(t)
+	addr := srv.Addr
+	srv.Close()
+
+	svc := NewStatusPlayerService(addr, log.NewNopLogger())
+	_, err := svc.List(context.Background())
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("List: expected ErrUnavailable, got %v", err)
+	}
+	if code := exthttp.GetHTTPCode(err, 0); code != http.StatusServiceUnavailable {
+		t.Errorf("List: expected HTTP code 503, got %d", code)
+	}
+}
@@ -0,0 +1,157 @@
+package minecraft
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// A TeleportService can move Players around a server.
+type TeleportService interface {
+	// Teleport moves a player to pos, and optionally rotates them to face
+	// orient. It returns the updated Player.
+	Teleport(
+		ctx context.Context,
+		username string,
+		pos Coordinates,
+		orient *Orientation,
+	) (*Player, error)
+
+	// TeleportTo moves a player to the position of another player (target). It
+	// returns the updated Player.
+	TeleportTo(ctx context.Context, username, target string) (*Player, error)
+}
+
+type teleportService struct {
+	client  *Client
+	players PlayerService
+	logger  log.Logger
+}
+
+// NewTeleportService creates a TeleportService.
+//
+// Updated Players are read from players, which should not be cached.
+func NewTeleportService(
+	c *Client,
+	players PlayerService,
+	logger log.Logger,
+) TeleportService {
+	return &teleportService{
+		client:  c,
+		players: players,
+		logger:  level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (svc *teleportService) Teleport(
+	ctx context.Context,
+	username string,
+	pos Coordinates,
+	orient *Orientation,
+) (_ *Player, err error) {
+	logger := log.With(svc.logger, "username", username, "position", pos)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Teleport", err)
+	}(time.Now())
+
+	target, err := command.Player(username)
+	if err != nil {
+		return nil, err
+	}
+	var cmd command.Command
+	if orient != nil {
+		cmd, err = command.TeleportRotated(
+			target,
+			pos.X, pos.Y, pos.Z,
+			orient.X, orient.Y,
+		)
+	} else {
+		cmd, err = command.Teleport(target, pos.X, pos.Y, pos.Z)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "build command")
+	}
+	if err = svc.teleport(cmd); err != nil {
+		return nil, err
+	}
+	return svc.players.Get(ctx, username)
+}
+
+func (svc *teleportService) TeleportTo(
+	ctx context.Context,
+	username, target string,
+) (_ *Player, err error) {
+	logger := log.With(svc.logger, "username", username, "target", target)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "TeleportTo", err)
+	}(time.Now())
+
+	src, err := command.Player(username)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := command.Player(target)
+	if err != nil {
+		return nil, errors.Wrap(err, "target")
+	}
+	cmd, err := command.TeleportToEntity(src, dest)
+	if err != nil {
+		return nil, errors.Wrap(err, "build command")
+	}
+	if err = svc.teleport(cmd); err != nil {
+		return nil, err
+	}
+	return svc.players.Get(ctx, username)
+}
+
+func (svc *teleportService) teleport(cmd command.Command) error {
+	out, err := svc.client.Execute(cmd)
+	if err != nil {
+		return errors.Wrap(err, "execute command")
+	}
+	if strings.HasPrefix(out, "Teleported ") {
+		return nil
+	}
+	return commandError(out)
+}
+
+// commandError interprets the output of a failed command as an error.
+func commandError(out string) error {
+	var (
+		err  = errors.Newf("minecraft: %s", out)
+		code int
+	)
+	switch {
+	case strings.HasPrefix(out, "No entity was found"),
+		strings.HasPrefix(out, "No player was found"):
+		err, code = errors.Mark(err, ErrNotFound), http.StatusNotFound
+	case strings.HasPrefix(out, "Invalid position"):
+		err, code = errors.Mark(err, ErrInvalidPosition), http.StatusBadRequest
+	default:
+		err, code = errors.Mark(err, ErrCommandFailed), http.StatusBadGateway
+	}
+	return exthttp.WrapWithHTTPCode(err, code)
+}
+
+var (
+	// ErrInvalidPosition is returned when the server rejects a position, i.e.
+	// because it is outside of the world.
+	ErrInvalidPosition = stderrors.New("minecraft: invalid position")
+
+	// ErrCommandFailed is returned when the server fails to execute a command
+	// for an unrecognized reason.
+	ErrCommandFailed = stderrors.New("minecraft: command failed")
+)
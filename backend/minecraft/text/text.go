@@ -0,0 +1,185 @@
+// Package text builds Minecraft JSON text components, as used by commands like
+// `tellraw`.
+//
+// Components are encoded with encoding/json, so any text they contain is
+// escaped properly. Legacy formatting codes (i.e. "§a") are stripped from the
+// text of Components built with Plain and Colored, so that it is always
+// displayed literally.
+package text
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// A Component is a JSON text component.
+type Component struct {
+	Text          string      `json:"text"`
+	Color         Color       `json:"color,omitempty"`
+	Bold          bool        `json:"bold,omitempty"`
+	Italic        bool        `json:"italic,omitempty"`
+	Underlined    bool        `json:"underlined,omitempty"`
+	Strikethrough bool        `json:"strikethrough,omitempty"`
+	Extra         []Component `json:"extra,omitempty"`
+}
+
+// Plain returns a Component containing the literal text s, without any
+// formatting codes.
+func Plain(s string) Component { return Component{Text: literal(s)} }
+
+// Colored returns a Component containing the literal text s, with color c.
+func Colored(s string, c Color) Component {
+	return Component{Text: literal(s), Color: c}
+}
+
+// Join returns a Component that displays each of children in sequence.
+func Join(children ...Component) Component {
+	return Component{Extra: children}
+}
+
+// WithColor returns a copy of c with the given color.
+func (c Component) WithColor(color Color) Component {
+	c.Color = color
+	return c
+}
+
+// WithBold returns a copy of c that is bold.
+func (c Component) WithBold() Component {
+	c.Bold = true
+	return c
+}
+
+// WithItalic returns a copy of c that is italic.
+func (c Component) WithItalic() Component {
+	c.Italic = true
+	return c
+}
+
+// Append returns a copy of c followed by children, which inherit its style.
+func (c Component) Append(children ...Component) Component {
+	extra := make([]Component, 0, len(c.Extra)+len(children))
+	c.Extra = append(append(extra, c.Extra...), children...)
+	return c
+}
+
+// String returns the plain text of c, without any styling.
+func (c Component) String() string {
+	var b strings.Builder
+	c.writeText(&b)
+	return b.String()
+}
+
+func (c Component) writeText(b *strings.Builder) {
+	b.WriteString(c.Text)
+	for _, child := range c.Extra {
+		child.writeText(b)
+	}
+}
+
+// JSON returns the JSON encoding of c.
+func (c Component) JSON() []byte {
+	data, err := json.Marshal(c)
+	if err != nil { // unreachable, since Component has no unsupported types
+		panic(err)
+	}
+	return data
+}
+
+// UnmarshalJSON decodes c from a JSON text component, which is either a string,
+// an object, or an array of components (of which the first is the parent of
+// the rest).
+func (c *Component) UnmarshalJSON(data []byte) error {
+	switch data = bytes.TrimSpace(data); {
+	case len(data) == 0:
+		return errors.New("text: empty component")
+	case data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*c = Component{Text: s}
+	case data[0] == '[':
+		var parts []Component
+		if err := json.Unmarshal(data, &parts); err != nil {
+			return err
+		}
+		if len(parts) == 0 {
+			*c = Component{}
+			return nil
+		}
+		*c = parts[0].Append(parts[1:]...)
+	default:
+		type component Component // prevent recursion
+		var v component
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*c = Component(v)
+	}
+	return nil
+}
+
+// A Color is the color of a Component: either a named color, or (on 1.16+
+// servers) a hex color of the form "#RRGGBB".
+type Color string
+
+// The set of named Colors.
+const (
+	Black       Color = "black"
+	DarkBlue    Color = "dark_blue"
+	DarkGreen   Color = "dark_green"
+	DarkAqua    Color = "dark_aqua"
+	DarkRed     Color = "dark_red"
+	DarkPurple  Color = "dark_purple"
+	Gold        Color = "gold"
+	Gray        Color = "gray"
+	DarkGray    Color = "dark_gray"
+	Blue        Color = "blue"
+	Green       Color = "green"
+	Aqua        Color = "aqua"
+	Red         Color = "red"
+	LightPurple Color = "light_purple"
+	Yellow      Color = "yellow"
+	White       Color = "white"
+)
+
+var (
+	namedColors = map[Color]bool{
+		Black: true, DarkBlue: true, DarkGreen: true, DarkAqua: true,
+		DarkRed: true, DarkPurple: true, Gold: true, Gray: true,
+		DarkGray: true, Blue: true, Green: true, Aqua: true, Red: true,
+		LightPurple: true, Yellow: true, White: true,
+	}
+	hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// ParseColor parses a Color from a string, which is either a named color (i.e.
+// "dark_red") or a hex color (i.e. "#FF0000").
+func ParseColor(s string) (Color, error) {
+	c := Color(strings.ToLower(strings.TrimSpace(s)))
+	if namedColors[c] || hexColorPattern.MatchString(string(c)) {
+		return c, nil
+	}
+	err := errors.Newf("text: unknown color '%s'", s)
+	return "", exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+}
+
+var codePattern = regexp.MustCompile(`(?i)§[0-9a-fk-orx]`)
+
+// StripCodes removes legacy formatting codes (i.e. "§a") from s, which are
+// still used by many servers in place of JSON text components.
+func StripCodes(s string) string {
+	return codePattern.ReplaceAllString(s, "")
+}
+
+// literal removes formatting codes from s, as well as any remaining section
+// signs, which could otherwise start new codes (i.e. in "§§aa").
+func literal(s string) string {
+	return strings.ReplaceAll(StripCodes(s), "§", "")
+}
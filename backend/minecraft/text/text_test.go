@@ -0,0 +1,135 @@
+package text
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+func TestComponentJSON(t *testing.T) {
+	tests := []struct {
+		c    Component
+		want string
+	}{
+		{Plain("hi"), `{"text":"hi"}`},
+		{Plain(`"quoted" \ back`), `{"text":"\"quoted\" \\ back"}`},
+		{Plain("two\nlines"), `{"text":"two\nlines"}`},
+		{Plain("<b>&</b>"), `{"text":"\u003cb\u003e\u0026\u003c/b\u003e"}`},
+		{Plain(`{"text":"injected"}`), `{"text":"{\"text\":\"injected\"}"}`},
+		{Colored("hi", Red).WithBold(), `{"text":"hi","color":"red","bold":true}`},
+		{
+			Join(Plain("a"), Colored("b", "#00FF00").WithItalic()),
+			`{"text":"","extra":[{"text":"a"},{"text":"b","color":"#00FF00","italic":true}]}`,
+		},
+	}
+	for _, test := range tests {
+		if got := string(test.c.JSON()); got != test.want {
+			t.Errorf("expected %s, got %s", test.want, got)
+		}
+		var c Component
+		if err := json.Unmarshal(test.c.JSON(), &c); err != nil {
+			t.Errorf("unmarshal %s: %v", test.want, err)
+			continue
+		}
+		if !reflect.DeepEqual(c, test.c) {
+			t.Errorf("expected %s to round-trip, got %+v", test.want, c)
+		}
+	}
+}
+
+func TestPlainStripsCodes(t *testing.T) {
+	for s, want := range map[string]string{
+		"hello":          "hello",
+		"§ahello":        "hello",
+		"§l§nbold§r":     "bold",
+		"§Khidden":       "hidden",
+		"§§aa":           "a",
+		"§zunknown":      "zunknown",
+		"trailing §":     "trailing ",
+		"100% §x§f§f§0s": "100% s",
+	} {
+		if got := Plain(s).Text; got != want {
+			t.Errorf("Plain(%q): expected %q, got %q", s, want, got)
+		}
+		if got := Colored(s, Red).Text; got != want {
+			t.Errorf("Colored(%q): expected %q, got %q", s, want, got)
+		}
+	}
+
+	// Components decoded from servers are kept intact.
+	var c Component
+	if err := json.Unmarshal([]byte(`"§aA Minecraft Server"`), &c); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if c.Text != "§aA Minecraft Server" {
+		t.Errorf("expected decoded text to be kept, got %q", c.Text)
+	}
+	if got := StripCodes(c.String()); got != "A Minecraft Server" {
+		t.Errorf("expected StripCodes to remove codes, got %q", got)
+	}
+}
+
+func TestComponentUnmarshal(t *testing.T) {
+	for data, want := range map[string]Component{
+		`"hi"`:                        Plain("hi"),
+		`{"text":"hi","color":"red"}`: Colored("hi", Red),
+		`[]`:                          {},
+		`["a",{"text":"b"}]`:          Plain("a").Append(Plain("b")),
+		`[{"text":"a","bold":true},"b"]`: Plain("a").WithBold().
+			Append(Plain("b")),
+	} {
+		var c Component
+		if err := json.Unmarshal([]byte(data), &c); err != nil {
+			t.Errorf("%s: %v", data, err)
+			continue
+		}
+		if !reflect.DeepEqual(c, want) {
+			t.Errorf("%s: expected %+v, got %+v", data, want, c)
+		}
+	}
+	if got := Plain("a").Append(Plain("b"), Plain("c")).String(); got != "abc" {
+		t.Errorf("expected plain text %q, got %q", "abc", got)
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	for s, want := range map[string]Color{
+		"red":         Red,
+		"dark_purple": DarkPurple,
+		" GOLD ":      Gold,
+		"#FF0000":     "#ff0000",
+		"#00ff7f":     "#00ff7f",
+	} {
+		c, err := ParseColor(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		if c != want {
+			t.Errorf("%q: expected %q, got %q", s, want, c)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"purple",
+		"dark red",
+		"#FFF",
+		"#GG0000",
+		"#FF00000",
+		"FF0000",
+		"§c",
+		`red","text":"`,
+	} {
+		_, err := ParseColor(s)
+		if err == nil {
+			t.Errorf("%q: expected an error", s)
+			continue
+		}
+		if code := exthttp.GetHTTPCode(err, 0); code != http.StatusBadRequest {
+			t.Errorf("%q: expected HTTP code %d, got %d", s, http.StatusBadRequest, code)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package minecraft
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/minecraft/command"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// TriggerObjective is the scoreboard objective that players set to their
+// login codes, with "/trigger zoomcraft set <code>".
+const TriggerObjective = "zoomcraft"
+
+type verifier struct {
+	client *Client
+	logger log.Logger
+
+	mu    sync.Mutex
+	ready bool // whether the objective has been created
+}
+
+var _ auth.Verifier = (*verifier)(nil)
+
+// NewVerifier creates an auth.Verifier that has players enter their codes
+// with the /trigger command, which any player can run, and reads them back
+// from the scoreboard.
+func NewVerifier(c *Client, logger log.Logger) auth.Verifier {
+	return &verifier{
+		client: c,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+}
+
+func (v *verifier) Prompt(
+	_ context.Context,
+	username string,
+	code int,
+) (_ string, err error) {
+	logger := log.With(v.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Prompt", err)
+	}(time.Now())
+
+	target, err := command.Player(username)
+	if err != nil {
+		return "", err
+	}
+	if err = v.setup(); err != nil {
+		return "", err
+	}
+	if err = v.enable(target); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/trigger %s set %d", TriggerObjective, code), nil
+}
+
+var scorePattern = regexp.MustCompile(`^\S+ has (-?\d+) \[`)
+
+func (v *verifier) Entered(_ context.Context, username string) (_ int, err error) {
+	logger := log.With(v.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Entered", err)
+	}(time.Now())
+
+	target, err := command.Player(username)
+	if err != nil {
+		return 0, err
+	}
+	cmd, err := command.GetScore(target, TriggerObjective)
+	if err != nil {
+		return 0, errors.Wrap(err, "build command")
+	}
+	out, err := v.client.Execute(cmd)
+	if err != nil {
+		return 0, errors.Wrap(err, "execute command")
+	}
+	if strings.HasPrefix(out, "Can't get value") { // no score is set
+		return 0, nil
+	}
+	match := scorePattern.FindStringSubmatch(out)
+	if match == nil {
+		return 0, commandError(out)
+	}
+	code, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, errors.Wrap(err, "parse score")
+	}
+	if code == 0 { // the score of a newly enabled trigger
+		return 0, nil
+	}
+
+	// Let the player enter another code (i.e. if it mistyped this one). The
+	// score is kept, since it may belong to another pending login.
+	if err = v.enable(target); err != nil {
+		return 0, err
+	}
+	return code, nil
+}
+
+func (v *verifier) Forget(_ context.Context, username string) (err error) {
+	logger := log.With(v.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Forget", err)
+	}(time.Now())
+
+	target, err := command.Player(username)
+	if err != nil {
+		return err
+	}
+	cmd, err := command.ResetScore(target, TriggerObjective)
+	if err != nil {
+		return errors.Wrap(err, "build command")
+	}
+	if _, err = v.client.Execute(cmd); err != nil {
+		return errors.Wrap(err, "execute command")
+	}
+	return nil
+}
+
+// enable allows target to set its score with the /trigger command, without
+// clearing its current score.
+//
+// Triggers are disabled whenever players use them, and whenever their scores
+// are reset, so they must be enabled after each use.
+func (v *verifier) enable(target command.Target) error {
+	cmd, err := command.EnableTrigger(target, TriggerObjective)
+	if err != nil {
+		return errors.Wrap(err, "build command")
+	}
+	out, err := v.client.Execute(cmd)
+	if err != nil {
+		return errors.Wrap(err, "execute command")
+	}
+	if !strings.HasPrefix(out, "Enabled trigger") {
+		return commandError(out)
+	}
+	return nil
+}
+
+// setup creates the trigger objective, if it has yet to be created.
+func (v *verifier) setup() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.ready {
+		return nil
+	}
+
+	cmd, err := command.AddTriggerObjective(TriggerObjective)
+	if err != nil {
+		return errors.Wrap(err, "build command")
+	}
+	out, err := v.client.Execute(cmd)
+	if err != nil {
+		return errors.Wrap(err, "execute command")
+	}
+	if !strings.HasPrefix(out, "Created new objective") &&
+		!strings.HasPrefix(out, "An objective already exists") {
+		return commandError(out)
+	}
+	v.ready = true
+	return nil
+}
@@ -0,0 +1,139 @@
+package minecraft
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+)
+
+// stubScoreboard answers the scoreboard commands used by a Verifier, like a
+// 1.16 server, and lets players use its triggers.
+type stubScoreboard struct {
+	mu      sync.Mutex
+	scores  map[string]int
+	enabled map[string]bool
+}
+
+func newStubScoreboard() *stubScoreboard {
+	return &stubScoreboard{
+		scores:  make(map[string]int),
+		enabled: make(map[string]bool),
+	}
+}
+
+func (s *stubScoreboard) respond(cmd string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var action, username, objective string
+	if cmd == "scoreboard objectives add zoomcraft trigger" {
+		return "Created new objective [zoomcraft]"
+	}
+	if _, err := fmt.Sscanf(
+		cmd, "scoreboard players %s %s %s",
+		&action, &username, &objective,
+	); err != nil || objective != TriggerObjective {
+		return "Unknown or incomplete command, see below for error"
+	}
+	switch action {
+	case "enable":
+		s.enabled[username] = true
+		return fmt.Sprintf("Enabled trigger [%s] for %s", objective, username)
+	case "get":
+		score, ok := s.scores[username]
+		if !ok {
+			return fmt.Sprintf(
+				"Can't get value of %s for %s; none is set",
+				objective, username,
+			)
+		}
+		return fmt.Sprintf("%s has %d [%s]", username, score, objective)
+	case "reset":
+		delete(s.scores, username)
+		delete(s.enabled, username)
+		return fmt.Sprintf("Reset score %s for %s", objective, username)
+	default:
+		return "Unknown or incomplete command, see below for error"
+	}
+}
+
+// trigger sets the score of the player with the given username, as
+// "/trigger zoomcraft set <code>" does. It reports whether the trigger was
+// enabled.
+func (s *stubScoreboard) trigger(username string, code int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.enabled[username] {
+		return false
+	}
+	s.scores[username] = code
+	s.enabled[username] = false
+	return true
+}
+
+func TestVerifier(t *testing.T) {
+	board := newStubScoreboard()
+	srv := newStubServer(t, board.respond)
+	c := NewClient(srv.Addr(), stubPassword)
+	defer c.Close()
+
+	var (
+		ctx = context.Background()
+		v   = NewVerifier(c, log.NewNopLogger())
+	)
+	entered := func(want int) {
+		t.Helper()
+		code, err := v.Entered(ctx, "Steve")
+		if err != nil {
+			t.Fatalf("entered: %v", err)
+		}
+		if code != want {
+			t.Fatalf("expected entered code %d, got %d", want, code)
+		}
+	}
+
+	instructions, err := v.Prompt(ctx, "Steve", 123456)
+	if err != nil {
+		t.Fatalf("prompt: %v", err)
+	}
+	if want := "/trigger zoomcraft set 123456"; instructions != want {
+		t.Errorf("expected instructions %q, got %q", want, instructions)
+	}
+	entered(0)
+
+	// Players can correct mistyped codes.
+	if !board.trigger("Steve", 12345) {
+		t.Fatal("trigger is disabled after prompt")
+	}
+	entered(12345)
+	if !board.trigger("Steve", 123456) {
+		t.Fatal("trigger is disabled after reading the entered code")
+	}
+
+	// Beginning another login does not forget the entered code.
+	if _, err = v.Prompt(ctx, "Steve", 654321); err != nil {
+		t.Fatalf("prompt: %v", err)
+	}
+	entered(123456)
+	entered(123456)
+
+	if err = v.Forget(ctx, "Steve"); err != nil {
+		t.Fatalf("forget: %v", err)
+	}
+	entered(0)
+
+	// Only Forget resets scores.
+	var resets int
+	for _, cmd := range srv.Commands() {
+		if strings.HasPrefix(cmd, "scoreboard players reset") {
+			resets++
+		}
+	}
+	if resets != 1 {
+		t.Errorf("expected 1 reset, got %d", resets)
+	}
+}
@@ -0,0 +1,163 @@
+package presence
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+// An Update describes changes to the entities in a world.
+type Update struct {
+	// Entities are the entities that joined or changed since the last update.
+	Entities []*Entity `json:"entities"`
+
+	// Departed are the IDs of entities that left since the last update.
+	Departed []string `json:"departed"`
+}
+
+// A Feed broadcasts Updates derived from the Events of a Watcher.
+//
+// Since all subscribers share the same Watcher, the load on the underlying
+// Provider does not depend on the number of subscribers.
+type Feed struct {
+	watcher *Watcher
+}
+
+// NewFeed creates a Feed that follows w.
+func NewFeed(w *Watcher) *Feed {
+	return &Feed{watcher: w}
+}
+
+// Subscribe returns a channel of Updates, the first of which contains all
+// entities currently in the world.
+//
+// The channel is closed when ctx is done. Updates that are not received in time
+// are merged together, so that slow subscribers never miss a change (and
+// never hold more than one update per entity). If the Feed falls behind its
+// Watcher, the channel is closed early, and the subscriber must resubscribe.
+func (f *Feed) Subscribe(ctx context.Context) <-chan *Update {
+	// Subscribe before taking the snapshot, so that no event is missed; events
+	// that are already reflected in the snapshot merge away harmlessly.
+	events := f.watcher.Subscribe(ctx)
+
+	sub := newFeedSub()
+	if entities, err := f.watcher.List(ctx); err == nil && len(entities) > 0 {
+		sortEntities(entities)
+		sub.push(&Update{Entities: entities})
+	}
+	go func() {
+		for event := range events {
+			var u Update
+			if event.Type == EntityLeft {
+				u.Departed = []string{event.Entity.ID}
+			} else {
+				u.Entities = []*Entity{event.Entity}
+			}
+			sub.push(&u)
+		}
+		sub.close()
+	}()
+
+	out := make(chan *Update)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.notify:
+			}
+			u, open := sub.take()
+			if len(u.Entities) > 0 || len(u.Departed) > 0 {
+				select {
+				case out <- u:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !open {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// A feedSub accumulates the updates that have yet to be delivered to a
+// subscriber.
+type feedSub struct {
+	mux      sync.Mutex
+	entities map[string]*Entity
+	departed map[string]types.Empty
+	closed   bool // whether no more updates will be pushed
+	notify   chan types.Empty
+}
+
+func newFeedSub() *feedSub {
+	return &feedSub{
+		entities: make(map[string]*Entity),
+		departed: make(map[string]types.Empty),
+		notify:   make(chan types.Empty, 1),
+	}
+}
+
+// push merges u into the pending update.
+func (sub *feedSub) push(u *Update) {
+	sub.mux.Lock()
+	for _, e := range u.Entities {
+		sub.entities[e.ID] = e
+		delete(sub.departed, e.ID)
+	}
+	for _, id := range u.Departed {
+		delete(sub.entities, id)
+		sub.departed[id] = types.Empty{}
+	}
+	sub.mux.Unlock()
+	sub.wake()
+}
+
+// close marks sub as closed, once its events are exhausted.
+func (sub *feedSub) close() {
+	sub.mux.Lock()
+	sub.closed = true
+	sub.mux.Unlock()
+	sub.wake()
+}
+
+func (sub *feedSub) wake() {
+	select {
+	case sub.notify <- types.Empty{}:
+	default: // already notified
+	}
+}
+
+// take removes and returns the pending update, and whether more updates may
+// follow.
+func (sub *feedSub) take() (*Update, bool) {
+	sub.mux.Lock()
+	defer sub.mux.Unlock()
+
+	u := Update{
+		Entities: make([]*Entity, 0, len(sub.entities)),
+		Departed: make([]string, 0, len(sub.departed)),
+	}
+	for id, e := range sub.entities {
+		u.Entities = append(u.Entities, e)
+		delete(sub.entities, id)
+	}
+	for id := range sub.departed {
+		u.Departed = append(u.Departed, id)
+		delete(sub.departed, id)
+	}
+	sortEntities(u.Entities)
+	sort.Strings(u.Departed)
+	return &u, !sub.closed
+}
+
+func sortEntities(entities []*Entity) {
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].ID < entities[j].ID
+	})
+}
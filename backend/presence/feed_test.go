@@ -0,0 +1,125 @@
+package presence
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+// nextUpdate receives the next Update from updates, or returns nil if none
+// arrives within wait.
+func nextUpdate(t *testing.T, updates <-chan *Update, wait time.Duration) *Update {
+	t.Helper()
+	select {
+	case u, ok := <-updates:
+		if !ok {
+			t.Fatal("updates closed")
+		}
+		return u
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func TestFeed(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		origin      = new(fakeProvider)
+		w           = NewWatcher(origin, 0, log.NewNopLogger())
+	)
+	defer cancel()
+	poll := func(entities ...*Entity) {
+		t.Helper()
+		origin.set(nil, entities...)
+		if err := w.poll(ctx); err != nil {
+			t.Fatalf("poll: %v", err)
+		}
+	}
+
+	steve := &Entity{ID: "Steve"}
+	alex := &Entity{ID: "Alex"}
+	poll(steve, alex)
+
+	// The first update holds the whole world.
+	updates := NewFeed(w).Subscribe(ctx)
+	u := nextUpdate(t, updates, time.Second)
+	if want := (&Update{Entities: []*Entity{alex, steve}}); u == nil ||
+		!reflect.DeepEqual(u.Entities, want.Entities) || len(u.Departed) != 0 {
+		t.Fatalf("expected %+v, got %+v", want, u)
+	}
+
+	// Later updates describe the changes to the world.
+	var (
+		notch = &Entity{ID: "Notch"}
+		moved = &Entity{ID: "Steve", Position: Position{X: 3}}
+	)
+	poll(steve, alex, notch)
+	poll(moved, notch)
+	poll(moved)
+
+	entities := map[string]*Entity{"Alex": alex, "Steve": steve}
+	for u := nextUpdate(t, updates, time.Second); u != nil; u = nextUpdate(t, updates, 50*time.Millisecond) {
+		for _, e := range u.Entities {
+			entities[e.ID] = e
+		}
+		for _, id := range u.Departed {
+			delete(entities, id)
+		}
+	}
+	if want := map[string]*Entity{"Steve": moved}; !reflect.DeepEqual(entities, want) {
+		t.Errorf("expected the world to be %+v, got %+v", want, entities)
+	}
+}
+
+// Updates that are not received in time are merged: only the last state of
+// each entity is sent, and entities that left are sent once.
+func TestFeedSubMerge(t *testing.T) {
+	sub := newFeedSub()
+	for _, u := range []*Update{
+		{Entities: []*Entity{{ID: "Steve", Position: Position{X: 1}}, {ID: "Alex"}}},
+		{Entities: []*Entity{{ID: "Steve", Position: Position{X: 2}}, {ID: "Notch"}}},
+		{Departed: []string{"Alex", "Notch"}},
+		{Departed: []string{"Jeb"}},
+		{Entities: []*Entity{{ID: "Jeb"}, {ID: "Steve", Position: Position{X: 3}}}},
+	} {
+		sub.push(u)
+	}
+	u, open := sub.take()
+	if !open {
+		t.Fatal("expected sub to be open")
+	}
+	want := &Update{
+		Entities: []*Entity{{ID: "Jeb"}, {ID: "Steve", Position: Position{X: 3}}},
+		Departed: []string{"Alex", "Notch"},
+	}
+	if !reflect.DeepEqual(u, want) {
+		t.Fatalf("expected %+v, got %+v", want, u)
+	}
+
+	// Once taken, updates are not sent again.
+	sub.close()
+	if u, open = sub.take(); open || len(u.Entities) != 0 || len(u.Departed) != 0 {
+		t.Fatalf("expected an empty, closed sub, got %+v (open = %t)", u, open)
+	}
+}
+
+// Feeds close their channels once their contexts are done.
+func TestFeedClosed(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		w           = NewWatcher(new(fakeProvider), 0, log.NewNopLogger())
+		updates     = NewFeed(w).Subscribe(ctx)
+	)
+	cancel()
+	select {
+	case _, ok := <-updates:
+		if ok {
+			t.Fatal("expected no updates")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for updates to be closed")
+	}
+}
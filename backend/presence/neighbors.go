@@ -0,0 +1,49 @@
+package presence
+
+import "sort"
+
+// DefaultHearingDistance is the default distance within which entities can
+// hear each other.
+const DefaultHearingDistance = 25.0
+
+// A Neighbor is an entity that is within hearing range of a listener.
+type Neighbor struct {
+	Entity *Entity `json:"entity"`
+
+	// Distance is the distance between the listener and the entity.
+	Distance float64 `json:"distance"`
+
+	// Direction is a unit vector pointing from the listener to the entity, in
+	// the listener's frame of reference (see Position.RelativeTo).
+	Direction Position `json:"direction"`
+
+	// Muffled reports whether sound between the listener and the entity is
+	// muffled (i.e. by a wall). It is never set by Neighbors.
+	Muffled bool `json:"muffled"`
+}
+
+// Neighbors returns the entities that are within maxDistance of listener, and
+// in the same space, ordered by distance.
+//
+// The listener itself is never included, nor are entities with unknown
+// positions.
+func Neighbors(listener *Entity, entities []*Entity, maxDistance float64) []*Neighbor {
+	neighbors := make([]*Neighbor, 0)
+	for _, e := range entities {
+		if e.ID == listener.ID || e.PositionUnknown || e.Space != listener.Space {
+			continue
+		}
+		rel := e.Position.RelativeTo(listener.Position, listener.Orientation)
+		if dist := rel.Length(); dist <= maxDistance {
+			neighbors = append(neighbors, &Neighbor{
+				Entity:    e,
+				Distance:  dist,
+				Direction: rel.Normalize(),
+			})
+		}
+	}
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].Distance < neighbors[j].Distance
+	})
+	return neighbors
+}
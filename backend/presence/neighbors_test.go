@@ -0,0 +1,87 @@
+package presence
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNeighbors(t *testing.T) {
+	// The listener stands at the origin facing south (+Z), so its right is
+	// west (-X).
+	listener := &Entity{ID: "Steve", Space: "overworld"}
+	entities := []*Entity{
+		listener,
+		{ID: "far", Space: "overworld", Position: Position{Z: 30}},
+		{ID: "behind", Space: "overworld", Position: Position{Z: -10}},
+		{ID: "right", Space: "overworld", Position: Position{X: -3}},
+		{ID: "edge", Space: "overworld", Position: Position{Y: 25}},
+		{ID: "nether", Space: "nether", Position: Position{X: 1}},
+		{ID: "unknown", PositionUnknown: true},
+	}
+
+	tests := []struct {
+		maxDistance float64
+		want        []Neighbor
+	}{
+		{
+			maxDistance: DefaultHearingDistance,
+			want: []Neighbor{
+				{Distance: 3, Direction: Position{X: 1}},
+				{Distance: 10, Direction: Position{Z: -1}},
+				{Distance: 25, Direction: Position{Y: 1}}, // inclusive
+			},
+		},
+		{
+			maxDistance: 5,
+			want:        []Neighbor{{Distance: 3, Direction: Position{X: 1}}},
+		},
+		{maxDistance: 1, want: []Neighbor{}},
+	}
+	for _, test := range tests {
+		got := Neighbors(listener, entities, test.maxDistance)
+		if len(got) != len(test.want) {
+			t.Fatalf(
+				"maxDistance %v: expected %d neighbors, got %d",
+				test.maxDistance, len(test.want), len(got),
+			)
+		}
+		for i, n := range got {
+			want := test.want[i]
+			if n.Distance != want.Distance ||
+				!approxEqual(n.Direction, want.Direction) {
+				t.Errorf(
+					"maxDistance %v: neighbor %d (%s): expected %v %v, got %v %v",
+					test.maxDistance, i, n.Entity.ID,
+					want.Distance, want.Direction, n.Distance, n.Direction,
+				)
+			}
+		}
+	}
+}
+
+// Neighbors with equal distances keep their original order.
+func TestNeighborsStable(t *testing.T) {
+	listener := &Entity{ID: "Steve"}
+	entities := []*Entity{
+		{ID: "a", Position: Position{X: 2}},
+		{ID: "b", Position: Position{X: -1}},
+		{ID: "c", Position: Position{Z: 2}},
+		{ID: "d", Position: Position{Y: -1}},
+	}
+	var ids string
+	for _, n := range Neighbors(listener, entities, 10) {
+		ids += n.Entity.ID
+	}
+	if ids != "bdac" {
+		t.Fatalf("expected order bdac, got %s", ids)
+	}
+}
+
+const epsilon = 1e-9
+
+// approxEqual reports whether p and q are equal, within floating-point error.
+func approxEqual(p, q Position) bool {
+	return math.Abs(p.X-q.X) < epsilon &&
+		math.Abs(p.Y-q.Y) < epsilon &&
+		math.Abs(p.Z-q.Z) < epsilon
+}
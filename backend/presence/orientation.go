@@ -0,0 +1,195 @@
+package presence
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/util/vecutil"
+)
+
+// An Orientation is the direction that an Entity faces, in degrees.
+//
+// Yaw is measured clockwise (when viewed from above) from +Z, and pitch is
+// measured downwards from the horizon, so that -90° faces straight up. These
+// are the conventions used by Minecraft; providers for games that use other
+// conventions must convert to them.
+type Orientation struct{ Yaw, Pitch float32 }
+
+var orientationComponents = []string{"yaw", "pitch"}
+
+func orientationFrom(parts []float64) Orientation {
+	return Orientation{Yaw: float32(parts[0]), Pitch: float32(parts[1])}
+}
+
+func (o Orientation) components() []float64 {
+	return []float64{float64(o.Yaw), float64(o.Pitch)}
+}
+
+func (o Orientation) radians() (yaw, pitch float64) {
+	return float64(o.Yaw) * math.Pi / 180, float64(o.Pitch) * math.Pi / 180
+}
+
+// Forward returns the unit vector in the direction that o faces.
+func (o Orientation) Forward() Position {
+	yaw, pitch := o.radians()
+	return Position{
+		X: -math.Sin(yaw) * math.Cos(pitch),
+		Y: -math.Sin(pitch),
+		Z: math.Cos(yaw) * math.Cos(pitch),
+	}
+}
+
+// Right returns the unit vector pointing to the right of o.
+//
+// It is always horizontal, since Orientations do not include roll.
+func (o Orientation) Right() Position {
+	yaw, _ := o.radians()
+	return Position{X: -math.Cos(yaw), Z: -math.Sin(yaw)}
+}
+
+// Up returns the unit vector pointing upwards from o, perpendicular to both
+// Forward and Right.
+func (o Orientation) Up() Position {
+	yaw, pitch := o.radians()
+	return Position{
+		X: -math.Sin(yaw) * math.Sin(pitch),
+		Y: math.Cos(pitch),
+		Z: math.Cos(yaw) * math.Sin(pitch),
+	}
+}
+
+// Facing returns the Orientation that faces in the direction of dir, which
+// need not be normalized.
+//
+// If dir is the zero vector, Facing returns the zero Orientation.
+func Facing(dir Position) Orientation {
+	if dir == (Position{}) {
+		return Orientation{}
+	}
+
+	// Negate by subtracting from zero, so that yaws lie in (-180°, 180°], and
+	// neither component is a negative zero (which is formatted as "-0").
+	var (
+		yaw   = math.Atan2(0-dir.X, dir.Z)
+		pitch = math.Atan2(0-dir.Y, math.Hypot(dir.X, dir.Z))
+	)
+	return Orientation{
+		Yaw:   float32(yaw * 180 / math.Pi),
+		Pitch: float32(pitch * 180 / math.Pi),
+	}
+}
+
+var _ fmt.Stringer = (*Orientation)(nil)
+
+func (o Orientation) String() string {
+	return "[" + vecutil.FormatComponents(o.components(), ", ", 32) + "]"
+}
+
+// Validate returns an error if any component of o is NaN or infinite.
+func (o Orientation) Validate() error {
+	if err := vecutil.CheckFinite(o.components()); err != nil {
+		return errors.Wrap(err, "presence: invalid Orientation")
+	}
+	return nil
+}
+
+var (
+	_ graphql.Marshaler   = (*Orientation)(nil)
+	_ graphql.Unmarshaler = (*Orientation)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+//
+// Orientations are marshalled as a list of numbers, i.e. [yaw, pitch].
+//
+// Non-finite components cannot be represented in JSON, and are rejected by
+// Validate where Orientations are built. Any that remain are clamped (see
+// vecutil.ClampFinite), so that non-null fields are never null.
+func (o Orientation) MarshalGQL(w io.Writer) {
+	parts := vecutil.ClampFinite(o.components(), 32)
+	io.WriteString(w, "["+vecutil.FormatComponents(parts, ",", 32)+"]")
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+//
+// It accepts lists of numbers, objects with the fields "yaw" and "pitch", and
+// strings in list or command syntax.
+func (o *Orientation) UnmarshalGQL(v interface{}) (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.WithDetail(err, "Failed to parse Orientation.")
+			err = exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+	}()
+
+	parts, err := vecutil.DecodeComponents(v, orientationComponents, 32)
+	if err != nil {
+		return err
+	}
+	*o = orientationFrom(parts)
+	return nil
+}
+
+var (
+	_ json.Marshaler   = (*Orientation)(nil)
+	_ json.Unmarshaler = (*Orientation)(nil)
+)
+
+// MarshalJSON implements json.Marshaler.
+//
+// Orientations are marshalled as a list of numbers, i.e. [yaw, pitch].
+func (o Orientation) MarshalJSON() ([]byte, error) {
+	parts := o.components()
+	if err := vecutil.CheckFinite(parts); err != nil {
+		return nil, errors.Wrap(err, "presence: marshal Orientation")
+	}
+	return []byte("[" + vecutil.FormatComponents(parts, ",", 32) + "]"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It accepts the same representations as UnmarshalGQL.
+func (o *Orientation) UnmarshalJSON(data []byte) error {
+	parts, err := vecutil.UnmarshalComponents(data, orientationComponents, 32)
+	if err != nil {
+		return errors.Wrap(err, "presence: unmarshal Orientation")
+	}
+	*o = orientationFrom(parts)
+	return nil
+}
+
+var (
+	_ encoding.TextMarshaler   = (*Orientation)(nil)
+	_ encoding.TextUnmarshaler = (*Orientation)(nil)
+)
+
+// MarshalText implements encoding.TextMarshaler.
+//
+// Orientations are marshalled in command syntax, i.e. "90 -15.5".
+func (o Orientation) MarshalText() ([]byte, error) {
+	parts := o.components()
+	if err := vecutil.CheckFinite(parts); err != nil {
+		return nil, errors.Wrap(err, "presence: marshal Orientation")
+	}
+	return []byte(vecutil.FormatComponents(parts, " ", 32)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+// It accepts strings in list or command syntax.
+func (o *Orientation) UnmarshalText(text []byte) error {
+	parts, err := vecutil.ParseComponents(string(text), orientationComponents, 32)
+	if err != nil {
+		return errors.Wrap(err, "presence: unmarshal Orientation")
+	}
+	*o = orientationFrom(parts)
+	return nil
+}
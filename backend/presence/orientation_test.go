@@ -0,0 +1,144 @@
+package presence
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+	"testing/quick"
+)
+
+// Known directions, as shown on the F3 screen in-game (i.e. "Facing: south
+// (Towards positive Z)" at a yaw of 0).
+var (
+	south = Position{Z: 1}
+	north = Position{Z: -1}
+	east  = Position{X: 1}
+	west  = Position{X: -1}
+	up    = Position{Y: 1}
+	down  = Position{Y: -1}
+)
+
+func TestOrientationDirections(t *testing.T) {
+	tests := []struct {
+		o                  Orientation
+		forward, right, up Position
+	}{
+		{Orientation{Yaw: 0}, south, west, up},
+		{Orientation{Yaw: 90}, west, north, up},
+		{Orientation{Yaw: 180}, north, east, up},
+		{Orientation{Yaw: -180}, north, east, up},
+		{Orientation{Yaw: -90}, east, south, up},
+		{Orientation{Yaw: 270}, east, south, up},
+		{Orientation{Yaw: 0, Pitch: -90}, up, west, north},
+		{Orientation{Yaw: 0, Pitch: 90}, down, west, south},
+		{Orientation{Yaw: 90, Pitch: -90}, up, north, east},
+		{
+			Orientation{Yaw: 45, Pitch: 0},
+			Position{X: -math.Sqrt2 / 2, Z: math.Sqrt2 / 2},  // southwest
+			Position{X: -math.Sqrt2 / 2, Z: -math.Sqrt2 / 2}, // northwest
+			up,
+		},
+		{
+			Orientation{Yaw: 0, Pitch: -45},
+			Position{Y: math.Sqrt2 / 2, Z: math.Sqrt2 / 2},
+			west,
+			Position{Y: math.Sqrt2 / 2, Z: -math.Sqrt2 / 2},
+		},
+	}
+	for _, test := range tests {
+		if got := test.o.Forward(); !approxEqual(got, test.forward) {
+			t.Errorf("%v: expected forward %v, got %v", test.o, test.forward, got)
+		}
+		if got := test.o.Right(); !approxEqual(got, test.right) {
+			t.Errorf("%v: expected right %v, got %v", test.o, test.right, got)
+		}
+		if got := test.o.Up(); !approxEqual(got, test.up) {
+			t.Errorf("%v: expected up %v, got %v", test.o, test.up, got)
+		}
+	}
+}
+
+// Forward, Right, and Up form an orthonormal basis for any Orientation.
+func TestOrientationBasis(t *testing.T) {
+	for yaw := float32(-180); yaw <= 180; yaw += 15 {
+		for pitch := float32(-90); pitch <= 90; pitch += 15 {
+			o := Orientation{Yaw: yaw, Pitch: pitch}
+			f, r, u := o.Forward(), o.Right(), o.Up()
+			for _, v := range []Position{f, r, u} {
+				if math.Abs(v.Length()-1) > epsilon {
+					t.Fatalf("%v: %v is not a unit vector", o, v)
+				}
+			}
+			if math.Abs(f.Dot(r)) > epsilon || math.Abs(f.Dot(u)) > epsilon ||
+				math.Abs(r.Dot(u)) > epsilon {
+				t.Fatalf("%v: %v, %v, %v are not orthogonal", o, f, r, u)
+			}
+		}
+	}
+}
+
+func TestFacing(t *testing.T) {
+	tests := []struct {
+		dir  Position
+		want Orientation
+	}{
+		{south, Orientation{Yaw: 0}},
+		{west.Scale(5), Orientation{Yaw: 90}},
+		{north, Orientation{Yaw: 180}},
+		{east, Orientation{Yaw: -90}},
+		{up, Orientation{Pitch: -90}},
+		{Position{X: -1, Y: -math.Sqrt2, Z: 1}, Orientation{Yaw: 45, Pitch: 45}},
+		{Position{}, Orientation{}},
+	}
+	for _, test := range tests {
+		got := Facing(test.dir)
+		if math.Abs(float64(got.Yaw-test.want.Yaw)) > 1e-4 ||
+			math.Abs(float64(got.Pitch-test.want.Pitch)) > 1e-4 {
+			t.Errorf("%v: expected %v, got %v", test.dir, test.want, got)
+		}
+
+		// Facing is the inverse of Forward.
+		if test.dir != (Position{}) {
+			if fwd := got.Forward(); fwd.Distance(test.dir.Normalize()) > 1e-6 {
+				t.Errorf("%v: expected forward %v, got %v", test.dir, test.dir, fwd)
+			}
+		}
+	}
+}
+
+func TestOrientationRoundTrip(t *testing.T) {
+	f := func(yaw, pitch float32) bool {
+		o := Orientation{Yaw: yaw, Pitch: pitch}
+		if err := checkRoundTrip(o, func() interface{} { return new(Orientation) }); err != nil {
+			t.Log(err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOrientationNonFinite(t *testing.T) {
+	o := Orientation{Yaw: float32(math.NaN()), Pitch: float32(math.Inf(-1))}
+	if err := o.Validate(); err == nil {
+		t.Error("expected Validate to fail")
+	}
+	if _, err := json.Marshal(o); err == nil {
+		t.Error("expected MarshalJSON to fail")
+	}
+	if _, err := o.MarshalText(); err == nil {
+		t.Error("expected MarshalText to fail")
+	}
+	var buf bytes.Buffer
+	want := "[0,-" + strconv.FormatFloat(math.MaxFloat32, 'f', -1, 32) + "]"
+	if o.MarshalGQL(&buf); buf.String() != want {
+		t.Errorf("expected MarshalGQL to write %s, got %s", want, &buf)
+	}
+	if err := o.UnmarshalText([]byte("Inf 0")); err == nil {
+		t.Error("expected UnmarshalText to fail")
+	}
+}
@@ -0,0 +1,185 @@
+package presence
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/util/vecutil"
+)
+
+// A Position is a point in a world, by its XYZ coordinates.
+//
+// Y points up; see Orientation for how directions relate to the other axes.
+type Position struct{ X, Y, Z float64 }
+
+var positionComponents = []string{"x", "y", "z"}
+
+func positionFrom(parts []float64) Position {
+	return Position{X: parts[0], Y: parts[1], Z: parts[2]}
+}
+
+func (p Position) components() []float64 { return []float64{p.X, p.Y, p.Z} }
+
+// Add returns the vector sum p + q.
+func (p Position) Add(q Position) Position {
+	return Position{X: p.X + q.X, Y: p.Y + q.Y, Z: p.Z + q.Z}
+}
+
+// Sub returns the vector difference p - q.
+func (p Position) Sub(q Position) Position {
+	return Position{X: p.X - q.X, Y: p.Y - q.Y, Z: p.Z - q.Z}
+}
+
+// Scale returns p scaled by k.
+func (p Position) Scale(k float64) Position {
+	return Position{X: p.X * k, Y: p.Y * k, Z: p.Z * k}
+}
+
+// Dot returns the dot product of p and q.
+func (p Position) Dot(q Position) float64 {
+	return p.X*q.X + p.Y*q.Y + p.Z*q.Z
+}
+
+// Length returns the Euclidean length of p.
+func (p Position) Length() float64 { return math.Sqrt(p.Dot(p)) }
+
+// Distance returns the Euclidean distance between p and q.
+func (p Position) Distance(q Position) float64 { return p.Sub(q).Length() }
+
+// Normalize returns the unit vector in the direction of p, or the zero vector
+// if p has no length.
+func (p Position) Normalize() Position {
+	l := p.Length()
+	if l == 0 {
+		return Position{}
+	}
+	return p.Scale(1 / l)
+}
+
+// Lerp linearly interpolates between p (at t = 0) and q (at t = 1).
+func (p Position) Lerp(q Position, t float64) Position {
+	return p.Add(q.Sub(p).Scale(t))
+}
+
+// RelativeTo returns p in the frame of reference of a listener at pos, facing
+// o: X points to the listener's right, Y points up from the listener's head,
+// and Z points in the direction that the listener is facing.
+func (p Position) RelativeTo(pos Position, o Orientation) Position {
+	d := p.Sub(pos)
+	return Position{X: d.Dot(o.Right()), Y: d.Dot(o.Up()), Z: d.Dot(o.Forward())}
+}
+
+var _ fmt.Stringer = (*Position)(nil)
+
+func (p Position) String() string {
+	return "[" + vecutil.FormatComponents(p.components(), ", ", 64) + "]"
+}
+
+// Validate returns an error if any component of p is NaN or infinite.
+func (p Position) Validate() error {
+	if err := vecutil.CheckFinite(p.components()); err != nil {
+		return errors.Wrap(err, "presence: invalid Position")
+	}
+	return nil
+}
+
+var (
+	_ graphql.Marshaler   = (*Position)(nil)
+	_ graphql.Unmarshaler = (*Position)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+//
+// Positions are marshalled as a list of numbers.
+//
+// Non-finite components cannot be represented in JSON, and are rejected by
+// Validate where Positions are built. Any that remain are clamped (see
+// vecutil.ClampFinite), so that non-null fields are never null.
+func (p Position) MarshalGQL(w io.Writer) {
+	parts := vecutil.ClampFinite(p.components(), 64)
+	io.WriteString(w, "["+vecutil.FormatComponents(parts, ",", 64)+"]")
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+//
+// It accepts lists of numbers, objects with the fields "x", "y", and "z", and
+// strings in list or command syntax.
+func (p *Position) UnmarshalGQL(v interface{}) (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.WithDetail(err, "Failed to parse Position.")
+			err = exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+	}()
+
+	parts, err := vecutil.DecodeComponents(v, positionComponents, 64)
+	if err != nil {
+		return err
+	}
+	*p = positionFrom(parts)
+	return nil
+}
+
+var (
+	_ json.Marshaler   = (*Position)(nil)
+	_ json.Unmarshaler = (*Position)(nil)
+)
+
+// MarshalJSON implements json.Marshaler.
+//
+// Positions are marshalled as a list of numbers.
+func (p Position) MarshalJSON() ([]byte, error) {
+	parts := p.components()
+	if err := vecutil.CheckFinite(parts); err != nil {
+		return nil, errors.Wrap(err, "presence: marshal Position")
+	}
+	return []byte("[" + vecutil.FormatComponents(parts, ",", 64) + "]"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It accepts the same representations as UnmarshalGQL.
+func (p *Position) UnmarshalJSON(data []byte) error {
+	parts, err := vecutil.UnmarshalComponents(data, positionComponents, 64)
+	if err != nil {
+		return errors.Wrap(err, "presence: unmarshal Position")
+	}
+	*p = positionFrom(parts)
+	return nil
+}
+
+var (
+	_ encoding.TextMarshaler   = (*Position)(nil)
+	_ encoding.TextUnmarshaler = (*Position)(nil)
+)
+
+// MarshalText implements encoding.TextMarshaler.
+//
+// Positions are marshalled in command syntax, i.e. "1.5 64 -3".
+func (p Position) MarshalText() ([]byte, error) {
+	parts := p.components()
+	if err := vecutil.CheckFinite(parts); err != nil {
+		return nil, errors.Wrap(err, "presence: marshal Position")
+	}
+	return []byte(vecutil.FormatComponents(parts, " ", 64)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+// It accepts strings in list or command syntax.
+func (p *Position) UnmarshalText(text []byte) error {
+	parts, err := vecutil.ParseComponents(string(text), positionComponents, 64)
+	if err != nil {
+		return errors.Wrap(err, "presence: unmarshal Position")
+	}
+	*p = positionFrom(parts)
+	return nil
+}
@@ -0,0 +1,199 @@
+package presence
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+	"testing/quick"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+)
+
+func TestPositionArithmetic(t *testing.T) {
+	var (
+		p = Position{X: 1, Y: 2, Z: 3}
+		q = Position{X: 4, Y: 6, Z: 15}
+	)
+	tests := []struct {
+		name      string
+		got, want Position
+	}{
+		{"Add", p.Add(q), Position{X: 5, Y: 8, Z: 18}},
+		{"Sub", q.Sub(p), Position{X: 3, Y: 4, Z: 12}},
+		{"Scale", p.Scale(-2), Position{X: -2, Y: -4, Z: -6}},
+		{"Lerp(0)", p.Lerp(q, 0), p},
+		{"Lerp(0.5)", p.Lerp(q, 0.5), Position{X: 2.5, Y: 4, Z: 9}},
+		{"Lerp(1)", p.Lerp(q, 1), q},
+		{"Normalize", Position{X: 3, Z: -4}.Normalize(), Position{X: 0.6, Z: -0.8}},
+		{"Normalize(0)", Position{}.Normalize(), Position{}},
+	}
+	for _, test := range tests {
+		if !approxEqual(test.got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, test.got)
+		}
+	}
+	if d := p.Distance(q); d != 13 {
+		t.Errorf("Distance: expected 13, got %v", d)
+	}
+	if d := p.Dot(q); d != 61 {
+		t.Errorf("Dot: expected 61, got %v", d)
+	}
+}
+
+func TestPositionRelativeTo(t *testing.T) {
+	// A listener at (100, 64, 100), and known positions around it.
+	listener := Position{X: 100, Y: 64, Z: 100}
+	tests := []struct {
+		name string
+		p    Position
+		o    Orientation
+		want Position // X is right, Y is up, Z is forward
+	}{
+		{"ahead, facing south", Position{X: 100, Y: 64, Z: 110}, Orientation{}, Position{Z: 10}},
+		{"behind, facing south", Position{X: 100, Y: 64, Z: 90}, Orientation{}, Position{Z: -10}},
+		{"west, facing south", Position{X: 95, Y: 64, Z: 100}, Orientation{}, Position{X: 5}},
+		{"above, facing south", Position{X: 100, Y: 70, Z: 100}, Orientation{}, Position{Y: 6}},
+		{"east, facing east", Position{X: 110, Y: 64, Z: 100}, Orientation{Yaw: -90}, Position{Z: 10}},
+		{"south, facing east", Position{X: 100, Y: 64, Z: 104}, Orientation{Yaw: -90}, Position{X: 4}},
+		{"east, facing north", Position{X: 102, Y: 64, Z: 100}, Orientation{Yaw: 180}, Position{X: 2}},
+		{"above, facing up", Position{X: 100, Y: 74, Z: 100}, Orientation{Pitch: -90}, Position{Z: 10}},
+		{"north, facing up", Position{X: 100, Y: 64, Z: 97}, Orientation{Pitch: -90}, Position{Y: 3}},
+		{
+			"ahead, facing southwest",
+			Position{X: 97, Y: 64, Z: 103},
+			Orientation{Yaw: 45},
+			Position{Z: 3 * math.Sqrt2},
+		},
+	}
+	for _, test := range tests {
+		got := test.p.RelativeTo(listener, test.o)
+		if !approxEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+
+		// Rotations preserve distances.
+		if d := test.p.Distance(listener); math.Abs(got.Length()-d) > epsilon {
+			t.Errorf("%s: expected length %v, got %v", test.name, d, got.Length())
+		}
+	}
+}
+
+// A vector is a type with the same representations as Position.
+type vector interface {
+	json.Marshaler
+	encoding.TextMarshaler
+	graphql.Marshaler
+}
+
+// checkRoundTrip checks that v survives being marshalled and unmarshalled into
+// a new value of the same type (through newV), in each representation.
+func checkRoundTrip(v vector, newV func() interface{}) error {
+	equal := func(format string, got interface{}) error {
+		got = reflect.ValueOf(got).Elem().Interface()
+		if got != v {
+			return errors.Newf("%s: expected %v, got %v", format, v, got)
+		}
+		return nil
+	}
+
+	// JSON
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	got := newV()
+	if err = json.Unmarshal(data, got); err != nil {
+		return err
+	}
+	if err = equal("JSON", got); err != nil {
+		return err
+	}
+
+	// Text
+	if data, err = v.MarshalText(); err != nil {
+		return err
+	}
+	got = newV()
+	if err = got.(encoding.TextUnmarshaler).UnmarshalText(data); err != nil {
+		return err
+	}
+	if err = equal("text", got); err != nil {
+		return err
+	}
+
+	// GraphQL, with input decoded from JSON variables (as gqlgen does).
+	var buf bytes.Buffer
+	v.MarshalGQL(&buf)
+	var input interface{}
+	dec := json.NewDecoder(&buf)
+	dec.UseNumber()
+	if err = dec.Decode(&input); err != nil {
+		return err
+	}
+	got = newV()
+	if err = got.(graphql.Unmarshaler).UnmarshalGQL(input); err != nil {
+		return err
+	}
+	return equal("GraphQL", got)
+}
+
+func TestPositionRoundTrip(t *testing.T) {
+	f := func(x, y, z float64) bool {
+		p := Position{X: x, Y: y, Z: z}
+		if err := checkRoundTrip(p, func() interface{} { return new(Position) }); err != nil {
+			t.Log(err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var maxFloat64 = strconv.FormatFloat(math.MaxFloat64, 'f', -1, 64)
+
+func TestPositionNonFinite(t *testing.T) {
+	for _, tc := range []struct {
+		p   Position
+		gql string
+	}{
+		{p: Position{X: math.NaN(), Y: 1}, gql: "[0,1,0]"},
+		{p: Position{Y: math.Inf(1)}, gql: "[0," + maxFloat64 + ",0]"},
+		{p: Position{Z: math.Inf(-1)}, gql: "[0,0,-" + maxFloat64 + "]"},
+	} {
+		p := tc.p
+		if err := p.Validate(); err == nil {
+			t.Errorf("%v: expected Validate to fail", p)
+		}
+		if _, err := json.Marshal(p); err == nil {
+			t.Errorf("%v: expected MarshalJSON to fail", p)
+		}
+		if _, err := p.MarshalText(); err == nil {
+			t.Errorf("%v: expected MarshalText to fail", p)
+		}
+
+		// Non-null fields must never be null, so MarshalGQL clamps.
+		var buf bytes.Buffer
+		if p.MarshalGQL(&buf); buf.String() != tc.gql {
+			t.Errorf("%v: expected MarshalGQL to write %s, got %s", p, tc.gql, &buf)
+		}
+	}
+
+	var p Position
+	for _, input := range []interface{}{
+		"NaN 0 0",
+		"[0, +Inf, 0]",
+		[]interface{}{0.0, 0.0, math.Inf(1)},
+		map[string]interface{}{"x": math.NaN(), "y": 0.0, "z": 0.0},
+	} {
+		if err := p.UnmarshalGQL(input); err == nil {
+			t.Errorf("%v: expected UnmarshalGQL to fail", input)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package presence describes the entities in a virtual world, independent of
+// the game that the world belongs to.
+//
+// Games are supported by implementing a Provider, which reports the Entities
+// in a world. Everything built on top of a Provider (i.e. proximity, feeds,
+// and the GraphQL API) works with any game.
+package presence
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+)
+
+// An Entity is something with a presence in a world, such as a player.
+type Entity struct {
+	// ID uniquely identifies the entity within its Provider, i.e. a player's
+	// username.
+	ID string `json:"id"`
+
+	// Space is the part of the world that the entity is in (i.e. a Minecraft
+	// dimension). Entities in different spaces cannot hear each other.
+	Space string `json:"space"`
+
+	Position    Position    `json:"position"`
+	Orientation Orientation `json:"orientation"`
+
+	// PositionUnknown is true if the Space, Position, and Orientation of the
+	// entity are unknown (and zero), because its Provider cannot report them.
+	PositionUnknown bool `json:"positionUnknown,omitempty"`
+}
+
+// RequirePosition returns an error marked as ErrUnsupported if the position of
+// e is unknown.
+func (e *Entity) RequirePosition() error {
+	if e.PositionUnknown {
+		return UnsupportedError("reading positions")
+	}
+	return nil
+}
+
+// Validate returns an error if the Position or Orientation of e is invalid.
+func (e *Entity) Validate() error {
+	if err := e.Position.Validate(); err != nil {
+		return err
+	}
+	return e.Orientation.Validate()
+}
+
+// A Provider reports the Entities in a world.
+type Provider interface {
+	// Get returns the Entity with the given ID, or an error marked as
+	// ErrNotFound if there is no such entity.
+	Get(ctx context.Context, id string) (*Entity, error)
+
+	// List returns all Entities in the world.
+	List(ctx context.Context) ([]*Entity, error)
+}
+
+// A Teleporter moves the Entities in a world, for games whose worlds can be
+// changed through their Provider.
+type Teleporter interface {
+	// Teleport moves the Entity with the given ID to pos, and optionally
+	// rotates it to face orient. It returns the updated Entity.
+	Teleport(
+		ctx context.Context,
+		id string,
+		pos Position,
+		orient *Orientation,
+	) (*Entity, error)
+
+	// TeleportTo moves the Entity with the given ID to the position of another
+	// Entity (target). It returns the updated Entity.
+	TeleportTo(ctx context.Context, id, target string) (*Entity, error)
+}
+
+var (
+	// ErrNotFound is returned when an Entity could not be found.
+	ErrNotFound = stderrors.New("presence: not found")
+
+	// ErrUnavailable is returned when a Provider is unable to reach its world.
+	ErrUnavailable = stderrors.New("presence: unavailable")
+
+	// ErrUnsupported is returned when an operation is not supported by a
+	// Provider.
+	ErrUnsupported = stderrors.New("presence: unsupported")
+)
+
+// UnsupportedError returns an error marked as ErrUnsupported, which describes
+// the unsupported operation op.
+func UnsupportedError(op string) error {
+	err := errors.Newf("presence: %s is not supported by this provider", op)
+	err = errors.Mark(err, ErrUnsupported)
+	return exthttp.WrapWithHTTPCode(err, http.StatusNotImplemented)
+}
@@ -0,0 +1,65 @@
+package presence
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// A Factory creates a Provider.
+type Factory func() (Provider, error)
+
+// A Registry is a set of named Factories, from which Providers can be opened
+// by name (i.e. according to configuration).
+//
+// It is safe for concurrent use.
+type Registry struct {
+	mux       sync.Mutex
+	factories map[string]Factory
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register adds a Factory to r under name.
+//
+// It panics if a Factory is already registered under name.
+func (r *Registry) Register(name string, f Factory) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if _, ok := r.factories[name]; ok {
+		panic(errors.Newf("presence: provider '%s' already registered", name))
+	}
+	r.factories[name] = f
+}
+
+// Names returns the names of the registered Factories, in sorted order.
+func (r *Registry) Names() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Open creates a Provider using the Factory registered under name.
+func (r *Registry) Open(name string) (Provider, error) {
+	r.mux.Lock()
+	f, ok := r.factories[name]
+	r.mux.Unlock()
+	if !ok {
+		err := errors.Newf("presence: unknown provider '%s'", name)
+		return nil, errors.WithHintf(err, "Available providers: %v.", r.Names())
+	}
+	p, err := f()
+	if err != nil {
+		return nil, errors.Wrapf(err, "presence: open provider '%s'", name)
+	}
+	return p, nil
+}
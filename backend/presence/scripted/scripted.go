@@ -0,0 +1,190 @@
+// Package scripted implements a presence.Provider whose entities follow
+// scripted paths, for demos and for testing without a game server.
+package scripted
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// A Script describes the position and orientation of an Actor at time t,
+// measured from the start of the script.
+type Script func(t time.Duration) (presence.Position, presence.Orientation)
+
+// An Actor is a scripted entity.
+type Actor struct {
+	ID     string
+	Space  string
+	Script Script
+
+	// Arrive is the time at which the actor enters the world.
+	Arrive time.Duration
+
+	// Depart is the time at which the actor leaves the world. If zero, the
+	// actor never leaves.
+	Depart time.Duration
+}
+
+func (a *Actor) present(t time.Duration) bool {
+	return t >= a.Arrive && (a.Depart == 0 || t < a.Depart)
+}
+
+func (a *Actor) entity(t time.Duration) *presence.Entity {
+	pos, orient := a.Script(t)
+	return &presence.Entity{
+		ID:          a.ID,
+		Space:       a.Space,
+		Position:    pos,
+		Orientation: orient,
+	}
+}
+
+// A Provider is a presence.Provider whose entities are played by Actors.
+type Provider struct {
+	actors []Actor
+	start  time.Time
+	now    func() time.Time
+}
+
+var _ presence.Provider = (*Provider)(nil)
+
+// Config configures a Provider.
+type Config struct {
+	// Now returns the current time. It defaults to time.Now, and can be
+	// replaced to control the progress of scripts (i.e. in tests).
+	Now func() time.Time
+}
+
+// New creates a Provider for actors, whose scripts start immediately.
+func New(actors []Actor, opts ...func(*Config)) *Provider {
+	cfg := Config{Now: time.Now}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Provider{
+		actors: actors,
+		start:  cfg.Now(),
+		now:    cfg.Now,
+	}
+}
+
+func (p *Provider) elapsed() time.Duration { return p.now().Sub(p.start) }
+
+// Get returns the entity played by the Actor with the given ID.
+func (p *Provider) Get(_ context.Context, id string) (*presence.Entity, error) {
+	t := p.elapsed()
+	for i := range p.actors {
+		if a := &p.actors[i]; a.ID == id && a.present(t) {
+			return a.entity(t), nil
+		}
+	}
+	return nil, presence.ErrNotFound
+}
+
+// List returns the entities played by all Actors that are present.
+func (p *Provider) List(context.Context) ([]*presence.Entity, error) {
+	t := p.elapsed()
+	entities := make([]*presence.Entity, 0, len(p.actors))
+	for i := range p.actors {
+		if a := &p.actors[i]; a.present(t) {
+			entities = append(entities, a.entity(t))
+		}
+	}
+	return entities, nil
+}
+
+// Still returns a Script that stands at pos, facing orient.
+func Still(pos presence.Position, orient presence.Orientation) Script {
+	return func(time.Duration) (presence.Position, presence.Orientation) {
+		return pos, orient
+	}
+}
+
+// Circle returns a Script that walks counterclockwise (when viewed from above)
+// around center in a circle of the given radius, completing a lap every period.
+// It faces the direction that it walks in.
+func Circle(center presence.Position, radius float64, period time.Duration) Script {
+	return func(t time.Duration) (presence.Position, presence.Orientation) {
+		theta := 2 * math.Pi * float64(t%period) / float64(period)
+		pos := presence.Position{
+			X: center.X + radius*math.Sin(theta),
+			Y: center.Y,
+			Z: center.Z + radius*math.Cos(theta),
+		}
+
+		dir := presence.Position{X: math.Cos(theta), Z: -math.Sin(theta)}
+		return pos, presence.Facing(dir)
+	}
+}
+
+// Path returns a Script that walks through waypoints in order at the given
+// speed (in units per second), and then back to the first waypoint, repeatedly.
+// It faces the direction that it walks in.
+func Path(speed float64, waypoints ...presence.Position) Script {
+	if len(waypoints) < 2 || speed <= 0 {
+		var pos presence.Position
+		if len(waypoints) > 0 {
+			pos = waypoints[0]
+		}
+		return Still(pos, presence.Orientation{})
+	}
+
+	// Compute the length of the loop, so that it can be traversed from the
+	// start on every call.
+	var length float64
+	for i, p := range waypoints {
+		length += p.Distance(waypoints[(i+1)%len(waypoints)])
+	}
+	if length == 0 {
+		return Still(waypoints[0], presence.Orientation{})
+	}
+	return func(t time.Duration) (presence.Position, presence.Orientation) {
+		d := math.Mod(speed*t.Seconds(), length)
+		for i, p := range waypoints {
+			q := waypoints[(i+1)%len(waypoints)]
+			seg := p.Distance(q)
+			if d < seg {
+				return p.Lerp(q, d/seg), presence.Facing(q.Sub(p))
+			}
+			d -= seg
+		}
+
+		// Rounding errors may carry d past the end of the loop.
+		first, last := waypoints[0], waypoints[len(waypoints)-1]
+		return first, presence.Facing(first.Sub(last))
+	}
+}
+
+// Turn returns a Script that stands at pos and turns clockwise (when viewed
+// from above), completing a revolution every period.
+func Turn(pos presence.Position, period time.Duration) Script {
+	return func(t time.Duration) (presence.Position, presence.Orientation) {
+		yaw := 360*float64(t%period)/float64(period) - 180
+		return pos, presence.Orientation{Yaw: float32(yaw)}
+	}
+}
+
+// Demo returns n Actors for demonstrations, which walk around the origin in
+// concentric circles at different speeds, in the space "demo".
+//
+// Actors arrive 5 seconds apart, so that joins can be observed.
+func Demo(n int) []Actor {
+	actors := make([]Actor, n)
+	for i := range actors {
+		var (
+			radius = 4 + 6*float64(i)
+			period = time.Duration(20+10*i) * time.Second
+		)
+		actors[i] = Actor{
+			ID:     fmt.Sprintf("Actor%d", i+1),
+			Space:  "demo",
+			Script: Circle(presence.Position{Y: 64}, radius, period),
+			Arrive: time.Duration(i) * 5 * time.Second,
+		}
+	}
+	return actors
+}
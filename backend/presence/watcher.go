@@ -0,0 +1,317 @@
+package presence
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// A Watcher polls an origin Provider at a fixed interval, and serves requests
+// from an in-memory snapshot of the world.
+//
+// Lookups never reach the origin, even for entities that are not in the
+// world, and each snapshot only holds the entities that were in the world when
+// it was taken.
+//
+// It also publishes Events describing the changes between snapshots.
+type Watcher struct {
+	origin   Provider
+	interval time.Duration
+	cfg      WatcherConfig
+	logger   log.Logger
+
+	snapshot atomic.Value // *snapshot
+	failures int32        // the number of consecutive failed polls
+
+	mux  sync.Mutex
+	subs map[*watcherSub]types.Empty
+}
+
+var _ Provider = (*Watcher)(nil)
+
+// WatcherConfig configures a Watcher.
+type WatcherConfig struct {
+	// MaxFailures is the number of consecutive failed polls after which the
+	// latest snapshot is considered stale, and the Watcher fails with
+	// ErrUnavailable until a poll succeeds.
+	MaxFailures int
+
+	// MaxQueue is the maximum number of Events queued for a subscriber. Slow
+	// subscribers that fall further behind are unsubscribed.
+	MaxQueue int
+}
+
+// NewWatcher creates a Watcher that polls origin every interval.
+//
+// It does not poll until Run is called.
+func NewWatcher(
+	origin Provider,
+	interval time.Duration,
+	logger log.Logger,
+	opts ...func(*WatcherConfig),
+) *Watcher {
+	cfg := WatcherConfig{MaxFailures: 3, MaxQueue: 1024}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Watcher{
+		origin:   origin,
+		interval: interval,
+		cfg:      cfg,
+		logger:   level.NewInjector(logger, level.DebugValue()),
+		subs:     make(map[*watcherSub]types.Empty),
+	}
+}
+
+// A snapshot is an immutable view of the entities in the world.
+type snapshot struct {
+	entities []*Entity
+	index    map[string]*Entity
+	taken    time.Time
+}
+
+// Run polls the origin Provider until ctx is done.
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+			l := logutil.WithError(w.logger, err)
+			logutil.Log(l, "failed to poll world")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) error {
+	entities, err := w.origin.List(ctx)
+	if err != nil {
+		atomic.AddInt32(&w.failures, 1)
+		return errors.Wrap(err, "list entities")
+	}
+	atomic.StoreInt32(&w.failures, 0)
+
+	next := &snapshot{
+		entities: make([]*Entity, 0, len(entities)),
+		index:    make(map[string]*Entity, len(entities)),
+		taken:    time.Now(),
+	}
+	for _, e := range entities {
+		// Skip entities that cannot be served, i.e. with non-finite positions.
+		if err := e.Validate(); err != nil {
+			l := log.With(logutil.WithError(w.logger, err), "id", e.ID)
+			logutil.Log(level.Warn(l), "skipped invalid entity")
+			continue
+		}
+		next.entities = append(next.entities, e)
+		next.index[e.ID] = e
+	}
+	entities = next.entities
+
+	// Compute events.
+	prev := w.load()
+	var events []Event
+	for _, e := range entities {
+		var last *Entity
+		if prev != nil {
+			last = prev.index[e.ID]
+		}
+		switch {
+		case last == nil:
+			events = append(events, Event{Type: EntityJoined, Entity: e})
+		case *last != *e:
+			events = append(events, Event{Type: EntityMoved, Entity: e})
+		}
+	}
+	if prev != nil {
+		for _, e := range prev.entities {
+			if _, ok := next.index[e.ID]; !ok {
+				events = append(events, Event{Type: EntityLeft, Entity: e})
+			}
+		}
+	}
+
+	w.snapshot.Store(next)
+	if len(events) == 0 {
+		return nil
+	}
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	for sub := range w.subs {
+		if !sub.push(events, w.cfg.MaxQueue) {
+			delete(w.subs, sub)
+			logutil.Log(level.Warn(w.logger), "dropped slow subscriber")
+		}
+	}
+	return nil
+}
+
+func (w *Watcher) load() *snapshot {
+	snap, _ := w.snapshot.Load().(*snapshot)
+	return snap
+}
+
+// loadReady is like load, but fails if no snapshot has been taken yet, or if
+// the latest snapshot is stale.
+func (w *Watcher) loadReady() (*snapshot, error) {
+	var err error
+	if snap := w.load(); snap == nil {
+		err = errors.New("presence: world has not been polled yet")
+	} else if n := atomic.LoadInt32(&w.failures); int(n) >= w.cfg.MaxFailures {
+		err = errors.Newf(
+			"presence: failed to poll world %d times (last polled %v ago)",
+			n, time.Since(snap.taken).Round(time.Millisecond),
+		)
+	} else {
+		return snap, nil
+	}
+	err = errors.Mark(err, ErrUnavailable)
+	return nil, exthttp.WrapWithHTTPCode(err, http.StatusServiceUnavailable)
+}
+
+// Get returns the Entity with the given ID from the latest snapshot.
+func (w *Watcher) Get(_ context.Context, id string) (*Entity, error) {
+	snap, err := w.loadReady()
+	if err != nil {
+		return nil, err
+	}
+	e, ok := snap.index[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return e, nil
+}
+
+// List returns all Entities from the latest snapshot.
+func (w *Watcher) List(context.Context) ([]*Entity, error) {
+	snap, err := w.loadReady()
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]*Entity, len(snap.entities))
+	copy(entities, snap.entities)
+	return entities, nil
+}
+
+// Subscribe returns a channel of Events, which is closed when ctx is done.
+//
+// Events are queued until they are received, so that subscribers never miss
+// an event. Subscribers that fall more than MaxQueue events behind are
+// unsubscribed instead, and their channels are closed.
+func (w *Watcher) Subscribe(ctx context.Context) <-chan Event {
+	sub := &watcherSub{notify: make(chan types.Empty, 1)}
+	w.mux.Lock()
+	w.subs[sub] = types.Empty{}
+	w.mux.Unlock()
+
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		defer func() {
+			w.mux.Lock()
+			delete(w.subs, sub)
+			w.mux.Unlock()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.notify:
+			}
+			events, ok := sub.take()
+			if !ok {
+				return
+			}
+			for _, event := range events {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+type watcherSub struct {
+	mux     sync.Mutex
+	queue   []Event
+	dropped bool // whether the queue overflowed
+	notify  chan types.Empty
+}
+
+// push queues events, unless the queue would hold more than max events, in
+// which case sub is dropped. It reports whether sub is still subscribed.
+func (sub *watcherSub) push(events []Event, max int) bool {
+	sub.mux.Lock()
+	if len(sub.queue)+len(events) > max {
+		sub.queue, sub.dropped = nil, true
+	} else {
+		sub.queue = append(sub.queue, events...)
+	}
+	dropped := sub.dropped
+	sub.mux.Unlock()
+
+	select {
+	case sub.notify <- types.Empty{}:
+	default: // already notified
+	}
+	return !dropped
+}
+
+// take removes and returns the queued events, or false if sub was dropped.
+func (sub *watcherSub) take() ([]Event, bool) {
+	sub.mux.Lock()
+	defer sub.mux.Unlock()
+	events := sub.queue
+	sub.queue = nil
+	return events, !sub.dropped
+}
+
+// An Event describes a change in the world.
+type Event struct {
+	Type EventType
+
+	// Entity is the state of the entity after the event; for EntityLeft, it is
+	// the last known state of the entity.
+	Entity *Entity
+}
+
+// An EventType describes the kind of an Event.
+type EventType int
+
+// The set of valid EventTypes.
+const (
+	EntityJoined EventType = iota
+	EntityLeft
+	EntityMoved
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EntityJoined:
+		return "joined"
+	case EntityLeft:
+		return "left"
+	case EntityMoved:
+		return "moved"
+	default:
+		return "unknown"
+	}
+}
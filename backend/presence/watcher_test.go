@@ -0,0 +1,338 @@
+package presence
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/go-kit/kit/log"
+)
+
+// fakeProvider is a Provider whose entities (or failure) are set by tests.
+type fakeProvider struct {
+	mu       sync.Mutex
+	entities []*Entity
+	err      error
+	gets     int
+	lists    int
+}
+
+func (p *fakeProvider) set(err error, entities ...*Entity) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entities, p.err = entities, err
+}
+
+func (p *fakeProvider) Get(ctx context.Context, id string) (*Entity, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gets++
+	if p.err != nil {
+		return nil, p.err
+	}
+	for _, e := range p.entities {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (p *fakeProvider) List(context.Context) ([]*Entity, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lists++
+	return p.entities, p.err
+}
+
+// calls returns the number of calls to Get and List.
+func (p *fakeProvider) calls() (gets, lists int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.gets, p.lists
+}
+
+// TestWatcherLookups checks that lookups never reach the origin, even for
+// players that are offline, and that departed players are evicted.
+func TestWatcherLookups(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		origin = new(fakeProvider)
+		steve  = &Entity{ID: "Steve"}
+		w      = NewWatcher(origin, 0, log.NewNopLogger())
+	)
+	origin.set(nil, steve)
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if _, err := w.Get(ctx, "Herobrine"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		if _, err := w.Get(ctx, "Steve"); err != nil {
+			t.Fatalf("get: %v", err)
+		}
+	}
+	if gets, lists := origin.calls(); gets != 0 || lists != 1 {
+		t.Fatalf("expected only the poll to reach the origin, got %d gets and %d lists", gets, lists)
+	}
+
+	origin.set(nil)
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	if _, err := w.Get(ctx, "Steve"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected departed player to be evicted, got %v", err)
+	}
+	if n := len(w.load().index); n != 0 {
+		t.Fatalf("expected an empty snapshot, got %d entries", n)
+	}
+}
+
+func TestWatcherStale(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		origin = new(fakeProvider)
+		steve  = &Entity{ID: "Steve", Position: Position{X: 1}}
+	)
+	w := NewWatcher(origin, 0, log.NewNopLogger(), func(cfg *WatcherConfig) {
+		cfg.MaxFailures = 2
+	})
+	expectAvailable := func(want bool) {
+		t.Helper()
+		_, err := w.Get(ctx, "Steve")
+		if _, lerr := w.List(ctx); errors.Is(err, ErrUnavailable) !=
+			errors.Is(lerr, ErrUnavailable) {
+			t.Fatalf("Get and List disagree: %v, %v", err, lerr)
+		}
+		if got := !errors.Is(err, ErrUnavailable); got != want {
+			t.Fatalf("expected available = %t, got error %v", want, err)
+		}
+	}
+
+	// The world is unavailable until it is first polled.
+	expectAvailable(false)
+	origin.set(nil, steve)
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	expectAvailable(true)
+
+	// Occasional failures are tolerated, by serving the last snapshot...
+	origin.set(errors.New("connection refused"))
+	if err := w.poll(ctx); err == nil {
+		t.Fatal("expected poll to fail")
+	}
+	expectAvailable(true)
+
+	// ...until the snapshot is considered stale.
+	if err := w.poll(ctx); err == nil {
+		t.Fatal("expected poll to fail")
+	}
+	expectAvailable(false)
+
+	origin.set(nil, steve)
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	expectAvailable(true)
+}
+
+func TestWatcherInvalid(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		origin = new(fakeProvider)
+		w      = NewWatcher(origin, 0, log.NewNopLogger())
+	)
+	origin.set(
+		nil,
+		&Entity{ID: "Steve"},
+		&Entity{ID: "Alex", Position: Position{Y: math.NaN()}},
+		&Entity{ID: "Notch", Orientation: Orientation{Yaw: float32(math.Inf(1))}},
+	)
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	entities, err := w.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(entities) != 1 || entities[0].ID != "Steve" {
+		t.Fatalf("expected only Steve to be served, got %v", entities)
+	}
+	if _, err = w.Get(ctx, "Alex"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an invalid entity, got %v", err)
+	}
+}
+
+// TestWatcherConcurrent reads from a Watcher while it polls, so that data
+// races are caught by `go test -race`.
+func TestWatcherConcurrent(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		origin      = new(fakeProvider)
+		w           = NewWatcher(origin, time.Millisecond, log.NewNopLogger())
+		wg          sync.WaitGroup
+	)
+	defer cancel()
+	origin.set(nil, &Entity{ID: "Steve"}, &Entity{ID: "Alex"})
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	go w.Run(ctx)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if i%2 == 0 {
+					_, err := w.Get(ctx, "Alex")
+					if err != nil && !errors.Is(err, ErrNotFound) {
+						t.Errorf("get: %v", err)
+					}
+				} else if _, err := w.List(ctx); err != nil {
+					t.Errorf("list: %v", err)
+				}
+			}
+		}(i)
+	}
+	for i := 0; i < 50; i++ {
+		origin.set(nil, &Entity{ID: "Steve", Position: Position{X: float64(i)}})
+		time.Sleep(100 * time.Microsecond)
+	}
+	wg.Wait()
+
+	// Departed entities are no longer served.
+	if err := w.poll(ctx); err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	if _, err := w.Get(ctx, "Alex"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if entities, _ := w.List(ctx); len(entities) != 1 {
+		t.Fatalf("expected 1 entity, got %d", len(entities))
+	}
+}
+
+// nextEvent receives the next Event from events, failing after a second.
+func nextEvent(t *testing.T, events <-chan Event) Event {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("events closed")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}
+	}
+}
+
+func TestWatcherEvents(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		origin      = new(fakeProvider)
+		w           = NewWatcher(origin, 0, log.NewNopLogger())
+		events      = w.Subscribe(ctx)
+	)
+	defer cancel()
+
+	steve := &Entity{ID: "Steve", Position: Position{X: 1}}
+	alex := &Entity{ID: "Alex", Position: Position{X: 2}}
+	moved := &Entity{ID: "Steve", Position: Position{X: 1, Z: 3}}
+	for _, step := range []struct {
+		entities []*Entity
+		want     []Event
+	}{
+		{
+			entities: []*Entity{steve, alex},
+			want: []Event{
+				{Type: EntityJoined, Entity: steve},
+				{Type: EntityJoined, Entity: alex},
+			},
+		},
+		{entities: []*Entity{steve, alex}}, // nothing changed
+		{
+			entities: []*Entity{moved},
+			want: []Event{
+				{Type: EntityMoved, Entity: moved},
+				{Type: EntityLeft, Entity: alex},
+			},
+		},
+	} {
+		origin.set(nil, step.entities...)
+		if err := w.poll(ctx); err != nil {
+			t.Fatalf("poll: %v", err)
+		}
+		for _, want := range step.want {
+			if got := nextEvent(t, events); got.Type != want.Type ||
+				*got.Entity != *want.Entity {
+				t.Fatalf("expected %s %+v, got %s %+v",
+					want.Type, *want.Entity, got.Type, *got.Entity)
+			}
+		}
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %s %+v", event.Type, *event.Entity)
+	default:
+	}
+
+	// Subscribers are removed once their contexts are done.
+	cancel()
+	for range events {
+	}
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if n := len(w.subs); n != 0 {
+		t.Fatalf("expected no subscribers, got %d", n)
+	}
+}
+
+func TestWatcherSlowSubscriber(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		origin = new(fakeProvider)
+		w      = NewWatcher(origin, 0, log.NewNopLogger(), func(cfg *WatcherConfig) {
+			cfg.MaxQueue = 4
+		})
+		events = w.Subscribe(ctx)
+	)
+
+	// The subscriber never receives, so its events pile up until it is
+	// dropped.
+	for i := 0; i < 8; i++ {
+		origin.set(nil, &Entity{ID: "Steve", Position: Position{X: float64(i)}})
+		if err := w.poll(ctx); err != nil {
+			t.Fatalf("poll: %v", err)
+		}
+	}
+	w.mux.Lock()
+	n := len(w.subs)
+	w.mux.Unlock()
+	if n != 0 {
+		t.Fatalf("expected the subscriber to be dropped, got %d subscribers", n)
+	}
+
+	// Its channel is closed, after at most the events that it was sent
+	// before falling behind.
+	timeout := time.After(time.Second)
+	for received := 0; ; received++ {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+			if received > 4 {
+				t.Fatalf("expected at most 4 events, got %d", received)
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for events to be closed")
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Package rooms groups players into voice rooms, which limit who they can
+// hear.
+//
+// Players join rooms explicitly, or implicitly by standing within a room's
+// Area (i.e. a "meeting room" build). Players in a room only hear the other
+// members of that room, and players outside of rooms only hear each other.
+//
+// Players can only join the rooms that they own, or were invited to.
+package rooms
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+// A Room is a group of players that can hear each other.
+type Room struct {
+	ID   types.ID `json:"id" bson:"id"`
+	Name string   `json:"name" bson:"name"`
+	Mode Mode     `json:"mode" bson:"mode"`
+
+	// Owner is the username of the player that created the room. Rooms
+	// without an owner (i.e. created while authentication was disabled) can
+	// be joined by any player.
+	Owner string `json:"owner,omitempty" bson:"owner,omitempty"`
+
+	// Invited are the usernames of the players that can join the room, in
+	// addition to its Owner.
+	Invited []string `json:"invited" bson:"invited"`
+
+	// Members are the usernames of the players that joined the room
+	// explicitly.
+	Members []string `json:"members" bson:"members"`
+
+	// Area is the area whose occupants are members of the room, in addition
+	// to its explicit Members. If nil, membership is only explicit.
+	Area *Area `json:"area,omitempty" bson:"area,omitempty"`
+}
+
+// HasMember reports whether the player with the given username joined r
+// explicitly.
+func (r *Room) HasMember(username string) bool {
+	return containsString(r.Members, username)
+}
+
+// CanJoin reports whether the player with the given username can join r.
+func (r *Room) CanJoin(username string) bool {
+	return r.Owner == "" || r.Owner == username ||
+		containsString(r.Invited, username)
+}
+
+func (r *Room) clone() *Room {
+	clone := *r
+	clone.Invited = append([]string(nil), r.Invited...)
+	clone.Members = append([]string(nil), r.Members...)
+	if r.Area != nil {
+		area := *r.Area
+		clone.Area = &area
+	}
+	return &clone
+}
+
+// A Mode determines how the members of a Room hear each other.
+type Mode string
+
+// The set of valid Modes.
+const (
+	// ModeProximity rooms behave like the open world: members only hear
+	// members that are nearby.
+	ModeProximity Mode = "PROXIMITY"
+
+	// ModeGlobal rooms behave like a call: members hear all other members,
+	// regardless of distance.
+	ModeGlobal Mode = "GLOBAL"
+)
+
+// Validate returns an error if m is not a valid Mode.
+func (m Mode) Validate() error {
+	switch m {
+	case ModeProximity, ModeGlobal:
+		return nil
+	default:
+		err := errors.Newf("rooms: invalid mode '%s'", string(m))
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+}
+
+func (m Mode) String() string { return string(m) }
+
+var (
+	_ graphql.Marshaler   = (*Mode)(nil)
+	_ graphql.Unmarshaler = (*Mode)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (m Mode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(m)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (m *Mode) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		err := errors.Newf("rooms: unsupported field type %T", v)
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	if err := Mode(s).Validate(); err != nil {
+		return err
+	}
+	*m = Mode(s)
+	return nil
+}
+
+// An Area is an axis-aligned box within a space.
+type Area struct {
+	Space string            `json:"space" bson:"space"`
+	Min   presence.Position `json:"min" bson:"min"`
+	Max   presence.Position `json:"max" bson:"max"`
+}
+
+// normalize ensures that each component of a.Min is no greater than the
+// corresponding component of a.Max.
+func (a *Area) normalize() {
+	a.Min, a.Max = presence.Position{
+		X: math.Min(a.Min.X, a.Max.X),
+		Y: math.Min(a.Min.Y, a.Max.Y),
+		Z: math.Min(a.Min.Z, a.Max.Z),
+	}, presence.Position{
+		X: math.Max(a.Min.X, a.Max.X),
+		Y: math.Max(a.Min.Y, a.Max.Y),
+		Z: math.Max(a.Min.Z, a.Max.Z),
+	}
+}
+
+// Contains reports whether e is within a.
+//
+// Entities whose positions are unknown are never within an Area.
+func (a *Area) Contains(e *presence.Entity) bool {
+	if e.PositionUnknown || e.Space != a.Space {
+		return false
+	}
+	p := e.Position
+	return p.X >= a.Min.X && p.X <= a.Max.X &&
+		p.Y >= a.Min.Y && p.Y <= a.Max.Y &&
+		p.Z >= a.Min.Z && p.Z <= a.Max.Z
+}
+
+// Find returns the Room that e is a member of, or nil if it is not in a room.
+//
+// Explicit membership takes precedence over being within a room's Area. If e
+// is within the Areas of several rooms, the first of them is returned.
+func Find(rooms []*Room, e *presence.Entity) *Room {
+	for _, r := range rooms {
+		if r.HasMember(e.ID) {
+			return r
+		}
+	}
+	for _, r := range rooms {
+		if r.Area != nil && r.Area.Contains(e) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Audible returns the entities that listener can hear given the rooms that
+// they are in, and the maximum distance at which it can hear them.
+//
+// Members of a room only hear the other members of that room (regardless of
+// distance, in ModeGlobal rooms), and players outside of rooms only hear each
+// other.
+func Audible(
+	rooms []*Room,
+	listener *presence.Entity,
+	entities []*presence.Entity,
+	maxDistance float64,
+) ([]*presence.Entity, float64) {
+	room := Find(rooms, listener)
+	audible := make([]*presence.Entity, 0, len(entities))
+	for _, e := range entities {
+		if Find(rooms, e) == room {
+			audible = append(audible, e)
+		}
+	}
+	if room != nil && room.Mode == ModeGlobal {
+		maxDistance = math.Inf(1)
+	}
+	return audible, maxDistance
+}
+
+// A Service manages Rooms.
+type Service interface {
+	// List returns all rooms, in the order that they were created.
+	List(ctx context.Context) ([]*Room, error)
+
+	// Get returns the room with the given ID.
+	Get(ctx context.Context, id types.ID) (*Room, error)
+
+	// Create creates a room owned by the player with the given username, or
+	// by no one if owner is empty. If area is non-nil, players within it are
+	// members of the room.
+	Create(
+		ctx context.Context,
+		owner, name string,
+		mode Mode,
+		area *Area,
+	) (*Room, error)
+
+	// Delete deletes the room with the given ID.
+	Delete(ctx context.Context, id types.ID) error
+
+	// Invite allows the player with the given username to join the room
+	// with the given ID.
+	Invite(ctx context.Context, id types.ID, username string) (*Room, error)
+
+	// Join adds the player with the given username to the room with the
+	// given ID, and removes it from any other room that it joined.
+	//
+	// It fails with ErrNotInvited unless the player can join the room (see
+	// Room.CanJoin).
+	Join(ctx context.Context, id types.ID, username string) (*Room, error)
+
+	// Leave removes the player with the given username from the room with
+	// the given ID.
+	//
+	// Players within the room's Area remain members of the room.
+	Leave(ctx context.Context, id types.ID, username string) (*Room, error)
+}
+
+// MaxNameLength is the maximum length of the name of a Room.
+const MaxNameLength = 64
+
+var (
+	// ErrNotFound is returned when a room could not be found.
+	ErrNotFound = stderrors.New("rooms: not found")
+
+	// ErrNotInvited is returned when a player joins a room that it was not
+	// invited to.
+	ErrNotInvited = stderrors.New("rooms: not invited")
+)
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
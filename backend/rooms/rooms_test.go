@@ -0,0 +1,150 @@
+package rooms
+
+import (
+	"math"
+	"testing"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+func entity(id, space string, x, y, z float64) *presence.Entity {
+	return &presence.Entity{
+		ID:       id,
+		Space:    space,
+		Position: presence.Position{X: x, Y: y, Z: z},
+	}
+}
+
+func TestAreaContains(t *testing.T) {
+	a := Area{
+		Space: "overworld",
+		Min:   presence.Position{X: 10, Y: 60, Z: -10},
+		Max:   presence.Position{X: 0, Y: 70, Z: 0},
+	}
+	a.normalize()
+	if want := (presence.Position{X: 0, Y: 60, Z: -10}); a.Min != want {
+		t.Fatalf("expected min %v, got %v", want, a.Min)
+	}
+
+	tests := []struct {
+		name string
+		e    *presence.Entity
+		want bool
+	}{
+		{"inside", entity("Steve", "overworld", 5, 64, -5), true},
+		{"min corner", entity("Steve", "overworld", 0, 60, -10), true},
+		{"max corner", entity("Steve", "overworld", 10, 70, 0), true},
+		{"outside", entity("Steve", "overworld", 10.1, 64, -5), false},
+		{"above", entity("Steve", "overworld", 5, 70.5, -5), false},
+		{"other space", entity("Steve", "nether", 5, 64, -5), false},
+		{
+			"unknown position",
+			&presence.Entity{ID: "Steve", Space: "overworld", PositionUnknown: true},
+			false,
+		},
+	}
+	for _, test := range tests {
+		if got := a.Contains(test.e); got != test.want {
+			t.Errorf("%s: Contains = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	var (
+		area = &Area{
+			Space: "overworld",
+			Max:   presence.Position{X: 10, Y: 100, Z: 10},
+		}
+		meeting = &Room{Name: "Meeting", Area: area}
+		lounge  = &Room{Name: "Lounge", Area: area}
+		call    = &Room{Name: "Call", Members: []string{"Alex"}}
+	)
+	rooms := []*Room{meeting, lounge, call}
+
+	tests := []struct {
+		e    *presence.Entity
+		want *Room
+	}{
+		// The first of several overlapping areas wins.
+		{entity("Steve", "overworld", 5, 64, 5), meeting},
+		// Explicit membership takes precedence over areas.
+		{entity("Alex", "overworld", 5, 64, 5), call},
+		{entity("Alex", "nether", 0, 0, 0), call},
+		{entity("Notch", "overworld", 50, 64, 50), nil},
+	}
+	for _, test := range tests {
+		if got := Find(rooms, test.e); got != test.want {
+			t.Errorf("%s: expected room %v, got %v", test.e.ID, test.want, got)
+		}
+	}
+}
+
+func TestAudible(t *testing.T) {
+	var (
+		steve = entity("Steve", "overworld", 0, 64, 0)
+		alex  = entity("Alex", "overworld", 1000, 64, 0)
+		notch = entity("Notch", "overworld", 5, 64, 0)
+		jeb   = entity("Jeb", "overworld", 10, 64, 0)
+		all   = []*presence.Entity{steve, alex, notch, jeb}
+	)
+	ids := func(entities []*presence.Entity) []string {
+		ids := make([]string, len(entities))
+		for i, e := range entities {
+			ids[i] = e.ID
+		}
+		return ids
+	}
+
+	for _, test := range []struct {
+		name     string
+		mode     Mode
+		listener *presence.Entity
+		audible  []string
+		distance float64
+	}{
+		// Members of global rooms hear each other at any distance.
+		{"global member", ModeGlobal, steve, []string{"Steve", "Alex"}, math.Inf(1)},
+		// Members of proximity rooms hear each other like the open world.
+		{"proximity member", ModeProximity, steve, []string{"Steve", "Alex"}, 32},
+		// Players outside of rooms only hear each other.
+		{"outsider", ModeGlobal, notch, []string{"Notch", "Jeb"}, 32},
+	} {
+		rooms := []*Room{{Name: "Room", Mode: test.mode, Members: []string{"Steve", "Alex"}}}
+		audible, distance := Audible(rooms, test.listener, all, 32)
+		if got := ids(audible); !equalStrings(got, test.audible) {
+			t.Errorf("%s: expected audible %q, got %q", test.name, test.audible, got)
+		}
+		if distance != test.distance {
+			t.Errorf("%s: expected distance %v, got %v", test.name, test.distance, distance)
+		}
+	}
+}
+
+func TestCanJoin(t *testing.T) {
+	r := &Room{Owner: "Steve", Invited: []string{"Alex"}}
+	for username, want := range map[string]bool{
+		"Steve": true,
+		"Alex":  true,
+		"Notch": false,
+	} {
+		if got := r.CanJoin(username); got != want {
+			t.Errorf("%s: CanJoin = %t, want %t", username, got, want)
+		}
+	}
+	if open := (&Room{}); !open.CanJoin("Notch") {
+		t.Error("expected rooms without owners to be open")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
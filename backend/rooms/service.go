@@ -0,0 +1,339 @@
+package rooms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/store"
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// Config configures a Service.
+type Config struct {
+	// ValidateUsername checks that a username is valid, i.e. that it follows
+	// the rules of the game that players are in. It is applied to the
+	// usernames of owners, and of the players that are invited to, join, or
+	// leave rooms.
+	//
+	// By default, usernames only need to be non-empty.
+	ValidateUsername func(username string) error
+}
+
+type service struct {
+	repo   store.Collection
+	cfg    Config
+	logger log.Logger
+
+	mu    sync.Mutex
+	rooms []*Room
+}
+
+// NewService creates a Service that keeps rooms in memory, and persists them
+// to repo.
+//
+// Rooms are loaded from repo when the Service is created, so repo must not be
+// shared with other Services.
+func NewService(
+	ctx context.Context,
+	repo store.Collection,
+	logger log.Logger,
+	opts ...func(*Config),
+) (Service, error) {
+	cfg := Config{ValidateUsername: validateUsername}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	svc := &service{
+		repo:   repo,
+		cfg:    cfg,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+	if err := repo.List(ctx, &svc.rooms); err != nil {
+		return nil, errors.Wrap(err, "rooms: load rooms")
+	}
+	return svc, nil
+}
+
+func (svc *service) List(context.Context) ([]*Room, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	rooms := make([]*Room, len(svc.rooms))
+	for i, r := range svc.rooms {
+		rooms[i] = r.clone()
+	}
+	return rooms, nil
+}
+
+func (svc *service) Get(_ context.Context, id types.ID) (*Room, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	return svc.rooms[i].clone(), nil
+}
+
+func (svc *service) Create(
+	ctx context.Context,
+	owner, name string,
+	mode Mode,
+	area *Area,
+) (_ *Room, err error) {
+	logger := log.With(svc.logger, "owner", owner, "name", name)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Create", err)
+	}(time.Now())
+
+	name = strings.TrimSpace(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
+	if owner != "" {
+		if err := svc.validateUsername(owner); err != nil {
+			return nil, err
+		}
+	}
+	room := Room{
+		ID:      types.NewID(),
+		Name:    name,
+		Mode:    mode,
+		Owner:   owner,
+		Invited: []string{},
+		Members: []string{},
+	}
+	if area != nil {
+		if area.Space == "" {
+			err := errors.New("rooms: area must have a space")
+			return nil, exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+		a := *area
+		a.normalize()
+		room.Area = &a
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if err := svc.save(ctx, &room); err != nil {
+		return nil, err
+	}
+	svc.rooms = append(svc.rooms, &room)
+	return room.clone(), nil
+}
+
+func (svc *service) Delete(ctx context.Context, id types.ID) (err error) {
+	logger := log.With(svc.logger, "id", id)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Delete", err)
+	}(time.Now())
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	if err := svc.repo.Delete(ctx, id.Hex()); err != nil {
+		return errors.Wrap(err, "rooms: delete room")
+	}
+	svc.rooms = append(svc.rooms[:i], svc.rooms[i+1:]...)
+	return nil
+}
+
+func (svc *service) Join(
+	ctx context.Context,
+	id types.ID,
+	username string,
+) (_ *Room, err error) {
+	logger := log.With(svc.logger, "id", id, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Join", err)
+	}(time.Now())
+
+	if err = svc.validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	if !svc.rooms[i].CanJoin(username) {
+		err := errors.WithStack(ErrNotInvited)
+		return nil, exthttp.WrapWithHTTPCode(err, http.StatusForbidden)
+	}
+
+	// Players can only join one room at a time.
+	for j, r := range svc.rooms {
+		if j == i || !r.HasMember(username) {
+			continue
+		}
+		left := r.clone()
+		left.Members = removeMember(left.Members, username)
+		if err := svc.save(ctx, left); err != nil {
+			return nil, err
+		}
+		svc.rooms[j] = left
+	}
+	room := svc.rooms[i].clone()
+	if !room.HasMember(username) {
+		room.Members = append(room.Members, username)
+	}
+	if err := svc.save(ctx, room); err != nil {
+		return nil, err
+	}
+	svc.rooms[i] = room
+	return room.clone(), nil
+}
+
+func (svc *service) Invite(
+	ctx context.Context,
+	id types.ID,
+	username string,
+) (_ *Room, err error) {
+	logger := log.With(svc.logger, "id", id, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Invite", err)
+	}(time.Now())
+
+	if err = svc.validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	room := svc.rooms[i].clone()
+	if room.CanJoin(username) {
+		return room, nil
+	}
+	room.Invited = append(room.Invited, username)
+	if err := svc.save(ctx, room); err != nil {
+		return nil, err
+	}
+	svc.rooms[i] = room
+	return room.clone(), nil
+}
+
+func (svc *service) Leave(
+	ctx context.Context,
+	id types.ID,
+	username string,
+) (_ *Room, err error) {
+	logger := log.With(svc.logger, "id", id, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Leave", err)
+	}(time.Now())
+
+	if err = svc.validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	room := svc.rooms[i].clone()
+	room.Members = removeMember(room.Members, username)
+	if err := svc.save(ctx, room); err != nil {
+		return nil, err
+	}
+	svc.rooms[i] = room
+	return room.clone(), nil
+}
+
+// save persists r to svc.repo.
+func (svc *service) save(ctx context.Context, r *Room) error {
+	return errors.Wrap(svc.repo.Put(ctx, r.ID.Hex(), r), "rooms: save room")
+}
+
+// find returns the index of the room with the given ID.
+//
+// svc.mu must be held by the caller.
+func (svc *service) find(id types.ID) (int, error) {
+	for i, r := range svc.rooms {
+		if r.ID == id {
+			return i, nil
+		}
+	}
+	err := errors.WithStack(ErrNotFound)
+	return 0, exthttp.WrapWithHTTPCode(err, http.StatusNotFound)
+}
+
+// validateUsername validates username with svc.cfg.ValidateUsername, which
+// cannot weaken the default validation.
+func (svc *service) validateUsername(username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
+	}
+	if err := svc.cfg.ValidateUsername(username); err != nil {
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+func validateUsername(username string) error {
+	if username == "" {
+		err := errors.New("rooms: empty username")
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+func validateName(name string) error {
+	var err error
+	switch n := utf8.RuneCountInString(name); {
+	case n == 0:
+		err = errors.New("rooms: empty name")
+	case n > MaxNameLength:
+		err = errors.Newf(
+			"rooms: name is longer than %d characters",
+			MaxNameLength,
+		)
+	default:
+		return nil
+	}
+	return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+}
+
+func removeMember(members []string, username string) []string {
+	filtered := members[:0]
+	for _, m := range members {
+		if m != username {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
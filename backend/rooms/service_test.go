@@ -0,0 +1,243 @@
+package rooms
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/store"
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+// errLowercase is returned by validateCapitalized.
+var errLowercase = errors.New("username must be capitalized")
+
+// validateCapitalized is a username validator that only accepts usernames
+// that begin with an uppercase letter.
+func validateCapitalized(username string) error {
+	if username[0] < 'A' || username[0] > 'Z' {
+		return errLowercase
+	}
+	return nil
+}
+
+func newService(t *testing.T, repo store.Collection) Service {
+	t.Helper()
+	if repo == nil {
+		db, err := store.NewFileStore("")
+		if err != nil {
+			t.Fatalf("open store: %v", err)
+		}
+		repo = db.Collection("rooms")
+	}
+	svc, err := NewService(
+		context.Background(), repo, log.NewNopLogger(),
+		func(cfg *Config) { cfg.ValidateUsername = validateCapitalized },
+	)
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	return svc
+}
+
+func create(t *testing.T, svc Service, owner, name string, mode Mode) *Room {
+	t.Helper()
+	r, err := svc.Create(context.Background(), owner, name, mode, nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	return r
+}
+
+func join(t *testing.T, svc Service, id types.ID, username string) *Room {
+	t.Helper()
+	r, err := svc.Join(context.Background(), id, username)
+	if err != nil {
+		t.Fatalf("join: %v", err)
+	}
+	return r
+}
+
+func expectCode(t *testing.T, err error, code int) {
+	t.Helper()
+	if got := exthttp.GetHTTPCode(err, 0); got != code {
+		t.Fatalf("expected HTTP code %d, got %d (%v)", code, got, err)
+	}
+}
+
+func expectMembers(t *testing.T, svc Service, id types.ID, want ...string) {
+	t.Helper()
+	r, err := svc.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if !equalStrings(r.Members, want) {
+		t.Fatalf("%s: expected members %q, got %q", r.Name, want, r.Members)
+	}
+}
+
+func TestServiceCreate(t *testing.T) {
+	var (
+		ctx = context.Background()
+		svc = newService(t, nil)
+	)
+	r, err := svc.Create(ctx, "Steve", "  Meeting ", ModeGlobal, &Area{
+		Space: "overworld",
+		Min:   presence.Position{X: 10, Y: 70, Z: 10},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if r.Name != "Meeting" || r.Owner != "Steve" || r.Mode != ModeGlobal {
+		t.Errorf("unexpected room %+v", r)
+	}
+	if want := (presence.Position{X: 10, Y: 70, Z: 10}); r.Area.Max != want {
+		t.Errorf("expected normalized area max %v, got %v", want, r.Area.Max)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		owner, room string
+		mode        Mode
+		area        *Area
+	}{
+		{name: "empty name", owner: "Steve", room: " ", mode: ModeGlobal},
+		{name: "invalid mode", owner: "Steve", room: "Room", mode: "LOUD"},
+		{name: "invalid owner", owner: "steve", room: "Room", mode: ModeGlobal},
+		{
+			name: "area without space", owner: "Steve", room: "Room",
+			mode: ModeGlobal, area: &Area{},
+		},
+	} {
+		_, err := svc.Create(ctx, tc.owner, tc.room, tc.mode, tc.area)
+		if code := exthttp.GetHTTPCode(err, 0); code != http.StatusBadRequest {
+			t.Errorf("%s: expected HTTP code %d, got %d (%v)", tc.name, http.StatusBadRequest, code, err)
+		}
+	}
+}
+
+func TestServiceJoin(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		svc    = newService(t, nil)
+		office = create(t, svc, "Steve", "Office", ModeProximity)
+		call   = create(t, svc, "Alex", "Call", ModeGlobal)
+	)
+
+	// Owners can join their own rooms, and joining twice is a no-op.
+	join(t, svc, office.ID, "Steve")
+	join(t, svc, office.ID, "Steve")
+	expectMembers(t, svc, office.ID, "Steve")
+
+	// Other players must be invited.
+	_, err := svc.Join(ctx, office.ID, "Notch")
+	if !errors.Is(err, ErrNotInvited) {
+		t.Fatalf("expected ErrNotInvited, got %v", err)
+	}
+	expectCode(t, err, http.StatusForbidden)
+	if _, err = svc.Invite(ctx, office.ID, "Notch"); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	join(t, svc, office.ID, "Notch")
+	expectMembers(t, svc, office.ID, "Steve", "Notch")
+
+	// Players can only be in one room at a time.
+	if _, err = svc.Invite(ctx, call.ID, "Steve"); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	join(t, svc, call.ID, "Steve")
+	expectMembers(t, svc, office.ID, "Notch")
+	expectMembers(t, svc, call.ID, "Steve")
+
+	// Usernames are validated.
+	_, err = svc.Join(ctx, call.ID, "alex")
+	if !errors.Is(err, errLowercase) {
+		t.Fatalf("expected errLowercase, got %v", err)
+	}
+	expectCode(t, err, http.StatusBadRequest)
+	_, err = svc.Join(ctx, call.ID, "")
+	expectCode(t, err, http.StatusBadRequest)
+	_, err = svc.Invite(ctx, call.ID, "notch")
+	expectCode(t, err, http.StatusBadRequest)
+
+	_, err = svc.Join(ctx, types.NewID(), "Steve")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	expectCode(t, err, http.StatusNotFound)
+
+	// Rooms without owners are open to all players.
+	open := create(t, svc, "", "Lobby", ModeProximity)
+	join(t, svc, open.ID, "Jeb")
+	expectMembers(t, svc, open.ID, "Jeb")
+}
+
+func TestServiceLeave(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		svc  = newService(t, nil)
+		area = &Area{Space: "overworld", Max: presence.Position{X: 10, Y: 100, Z: 10}}
+	)
+	r, err := svc.Create(ctx, "Steve", "Meeting", ModeGlobal, area)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	join(t, svc, r.ID, "Steve")
+
+	if r, err = svc.Leave(ctx, r.ID, "Steve"); err != nil {
+		t.Fatalf("leave: %v", err)
+	}
+	if len(r.Members) != 0 {
+		t.Fatalf("expected no members, got %q", r.Members)
+	}
+
+	// Players within the room's area remain members.
+	steve := entity("Steve", "overworld", 5, 64, 5)
+	if found := Find([]*Room{r}, steve); found == nil || found.ID != r.ID {
+		t.Errorf("expected Steve to be in the room through its area, got %v", found)
+	}
+
+	_, err = svc.Leave(ctx, r.ID, "steve")
+	expectCode(t, err, http.StatusBadRequest)
+}
+
+func TestServicePersistence(t *testing.T) {
+	db, err := store.NewFileStore("")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	var (
+		ctx  = context.Background()
+		repo = db.Collection("rooms")
+		svc  = newService(t, repo)
+		r    = create(t, svc, "Steve", "Office", ModeGlobal)
+	)
+	if _, err = svc.Invite(ctx, r.ID, "Alex"); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	join(t, svc, r.ID, "Alex")
+
+	// Rooms are loaded when services are created.
+	svc = newService(t, repo)
+	r, err = svc.Get(ctx, r.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if r.Owner != "Steve" || !equalStrings(r.Invited, []string{"Alex"}) ||
+		!equalStrings(r.Members, []string{"Alex"}) {
+		t.Errorf("unexpected room after reload: %+v", r)
+	}
+
+	if err = svc.Delete(ctx, r.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	svc = newService(t, repo)
+	if rooms, err := svc.List(ctx); err != nil || len(rooms) != 0 {
+		t.Errorf("expected no rooms after delete, got %v (%v)", rooms, err)
+	}
+}
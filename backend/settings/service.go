@@ -0,0 +1,142 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/store"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+type service struct {
+	repo   store.Collection
+	cfg    Config
+	logger log.Logger
+
+	mu       sync.Mutex
+	settings map[string]*Settings // by username
+}
+
+// Config configures a Service.
+type Config struct {
+	// ValidateUsername checks that a username is valid, i.e. that it follows
+	// the rules of the game that players are in. It is applied to the
+	// usernames of players, and of the players that they mute.
+	//
+	// By default, usernames only need to be non-empty.
+	ValidateUsername func(username string) error
+}
+
+// NewService creates a Service that keeps settings in memory, and persists
+// them to repo.
+//
+// Settings are loaded from repo when the Service is created, so repo must not
+// be shared with other Services.
+func NewService(
+	ctx context.Context,
+	repo store.Collection,
+	logger log.Logger,
+	opts ...func(*Config),
+) (Service, error) {
+	cfg := Config{ValidateUsername: validateUsername}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var settings []*Settings
+	if err := repo.List(ctx, &settings); err != nil {
+		return nil, errors.Wrap(err, "settings: load settings")
+	}
+	svc := &service{
+		repo:     repo,
+		cfg:      cfg,
+		logger:   level.NewInjector(logger, level.DebugValue()),
+		settings: make(map[string]*Settings, len(settings)),
+	}
+	for _, s := range settings {
+		svc.settings[s.Username] = s
+	}
+	return svc, nil
+}
+
+func (svc *service) Get(_ context.Context, username string) (*Settings, error) {
+	if err := svc.validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	return svc.get(username).clone(), nil
+}
+
+func (svc *service) Update(
+	ctx context.Context,
+	username string,
+	u *Update,
+) (_ *Settings, err error) {
+	logger := log.With(svc.logger, "username", username)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Update", err)
+	}(time.Now())
+
+	if err = svc.validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	settings := svc.get(username).clone()
+	u.apply(settings)
+	if err = settings.Validate(); err != nil {
+		return nil, err
+	}
+	if err = settings.validateUsernames(svc.validateUsername); err != nil {
+		return nil, err
+	}
+	if err = svc.repo.Put(ctx, username, settings); err != nil {
+		return nil, errors.Wrap(err, "settings: save settings")
+	}
+	svc.settings[username] = settings
+	return settings.clone(), nil
+}
+
+// get returns the settings of the player with the given username, which must
+// not be modified.
+//
+// svc.mu must be held by the caller.
+func (svc *service) get(username string) *Settings {
+	if s, ok := svc.settings[username]; ok {
+		return s
+	}
+	return Default(username)
+}
+
+// validateUsername validates username with svc.cfg.ValidateUsername, which
+// cannot weaken the default validation.
+func (svc *service) validateUsername(username string) error {
+	if err := validateUsername(username); err != nil {
+		return err
+	}
+	if err := svc.cfg.ValidateUsername(username); err != nil {
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+func validateUsername(username string) error {
+	if username == "" {
+		err := errors.New("settings: empty username")
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+	"github.com/go-kit/kit/log"
+
+	"go.stevenxie.me/zoomcraft/backend/store"
+)
+
+// errLowercase is returned by validateCapitalized.
+var errLowercase = errors.New("username must be capitalized")
+
+// validateCapitalized is a username validator that only accepts usernames
+// that begin with an uppercase letter.
+func validateCapitalized(username string) error {
+	if username[0] < 'A' || username[0] > 'Z' {
+		return errLowercase
+	}
+	return nil
+}
+
+func newService(t *testing.T, opts ...func(*Config)) Service {
+	t.Helper()
+	db, err := store.NewFileStore("")
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	svc, err := NewService(
+		context.Background(),
+		db.Collection("settings"),
+		log.NewNopLogger(),
+		opts...,
+	)
+	if err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+	return svc
+}
+
+func TestServiceValidateUsername(t *testing.T) {
+	var (
+		ctx = context.Background()
+		svc = newService(t, func(cfg *Config) {
+			cfg.ValidateUsername = validateCapitalized
+		})
+	)
+	expectInvalid := func(err error, target error) {
+		t.Helper()
+		if target != nil && !errors.Is(err, target) {
+			t.Fatalf("expected %v, got %v", target, err)
+		}
+		if code := exthttp.GetHTTPCode(err, 0); code != http.StatusBadRequest {
+			t.Fatalf("expected HTTP code %d, got %d (%v)", http.StatusBadRequest, code, err)
+		}
+	}
+
+	s, err := svc.Update(ctx, "Steve", &Update{Muted: []string{" Alex", "Notch"}})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if want := []string{"Alex", "Notch"}; !reflect.DeepEqual(s.Muted, want) {
+		t.Errorf("Muted = %q, want %q", s.Muted, want)
+	}
+
+	// Owners and muted players are validated in the same way.
+	_, err = svc.Get(ctx, "steve")
+	expectInvalid(err, errLowercase)
+	_, err = svc.Update(ctx, "steve", &Update{})
+	expectInvalid(err, errLowercase)
+	_, err = svc.Update(ctx, "Steve", &Update{Muted: []string{"Alex", "notch"}})
+	expectInvalid(err, errLowercase)
+
+	// Validators cannot weaken the default validation.
+	_, err = svc.Get(ctx, "")
+	expectInvalid(err, nil)
+
+	// Rejected updates are not applied.
+	if s, err = svc.Get(ctx, "Steve"); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if want := []string{"Alex", "Notch"}; !reflect.DeepEqual(s.Muted, want) {
+		t.Errorf("Muted = %q, want %q", s.Muted, want)
+	}
+}
+
+func TestServiceDefaultUsernames(t *testing.T) {
+	svc := newService(t)
+	s, err := svc.Update(context.Background(), "steve", &Update{
+		Muted: []string{"Zoë", "Alex Steve"},
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if want := []string{"Zoë", "Alex Steve"}; !reflect.DeepEqual(s.Muted, want) {
+		t.Errorf("Muted = %q, want %q", s.Muted, want)
+	}
+}
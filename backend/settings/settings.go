@@ -0,0 +1,226 @@
+// Package settings defines the preferences of each player, such as how far
+// away they can hear other players.
+package settings
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// Settings are the preferences of a player.
+type Settings struct {
+	Username string `json:"username" bson:"username"`
+
+	// HearingDistance is the distance within which the player hears other
+	// players.
+	HearingDistance float64 `json:"hearingDistance" bson:"hearingDistance"`
+
+	// Rolloff determines how quickly the volume of other players decreases
+	// with distance.
+	Rolloff Rolloff `json:"rolloff" bson:"rolloff"`
+
+	// Muted are the usernames of the players that the player does not hear.
+	Muted []string `json:"muted" bson:"muted"`
+
+	// PushToTalk is true if the player only transmits audio while holding
+	// down a key.
+	PushToTalk bool `json:"pushToTalk" bson:"pushToTalk"`
+}
+
+// Default returns the settings of a player that has never changed them.
+func Default(username string) *Settings {
+	return &Settings{
+		Username:        username,
+		HearingDistance: presence.DefaultHearingDistance,
+		Rolloff:         RolloffLinear,
+		Muted:           []string{},
+	}
+}
+
+// HasMuted reports whether the player with the given username is muted.
+func (s *Settings) HasMuted(username string) bool {
+	for _, m := range s.Muted {
+		if m == username {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that s is well-formed, and normalizes its mute list.
+func (s *Settings) Validate() error {
+	var err error
+	switch {
+	case s.Username == "":
+		err = errors.New("settings: empty username")
+	case !(s.HearingDistance > 0 && s.HearingDistance <= MaxHearingDistance):
+		err = errors.Newf(
+			"settings: hearing distance must be within (0, %g]",
+			MaxHearingDistance,
+		)
+	case len(s.Muted) > MaxMuted:
+		err = errors.Newf(
+			"settings: cannot mute more than %d players",
+			MaxMuted,
+		)
+	default:
+		if err = s.Rolloff.Validate(); err != nil {
+			return err
+		}
+		err = s.normalizeMuted()
+	}
+	if err != nil {
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	return nil
+}
+
+// normalizeMuted trims and deduplicates s.Muted.
+func (s *Settings) normalizeMuted() error {
+	muted := make([]string, 0, len(s.Muted))
+	for _, m := range s.Muted {
+		if m = strings.TrimSpace(m); m == "" {
+			return errors.New("settings: empty muted username")
+		}
+		if m == s.Username {
+			return errors.New("settings: players cannot mute themselves")
+		}
+		if !containsString(muted, m) {
+			muted = append(muted, m)
+		}
+	}
+	s.Muted = muted
+	return nil
+}
+
+// validateUsernames checks the username of s, and each of s.Muted, with
+// validate.
+func (s *Settings) validateUsernames(validate func(string) error) error {
+	if err := validate(s.Username); err != nil {
+		return err
+	}
+	for _, m := range s.Muted {
+		if err := validate(m); err != nil {
+			return errors.Wrap(err, "settings: muted username")
+		}
+	}
+	return nil
+}
+
+func (s *Settings) clone() *Settings {
+	clone := *s
+	clone.Muted = append([]string{}, s.Muted...)
+	return &clone
+}
+
+// An Update changes some Settings. Nil fields are left unchanged.
+type Update struct {
+	HearingDistance *float64 `json:"hearingDistance"`
+	Rolloff         *Rolloff `json:"rolloff"`
+	Muted           []string `json:"muted"`
+	PushToTalk      *bool    `json:"pushToTalk"`
+}
+
+// apply applies u to s.
+func (u *Update) apply(s *Settings) {
+	if u.HearingDistance != nil {
+		s.HearingDistance = *u.HearingDistance
+	}
+	if u.Rolloff != nil {
+		s.Rolloff = *u.Rolloff
+	}
+	if u.Muted != nil {
+		s.Muted = append([]string{}, u.Muted...)
+	}
+	if u.PushToTalk != nil {
+		s.PushToTalk = *u.PushToTalk
+	}
+}
+
+// A Rolloff determines how quickly the volume of a sound decreases with
+// distance. Rolloffs correspond to the distance models of the Web Audio API.
+type Rolloff string
+
+// The set of valid Rolloffs.
+const (
+	RolloffLinear      Rolloff = "LINEAR"
+	RolloffInverse     Rolloff = "INVERSE"
+	RolloffExponential Rolloff = "EXPONENTIAL"
+)
+
+// Validate returns an error if r is not a valid Rolloff.
+func (r Rolloff) Validate() error {
+	switch r {
+	case RolloffLinear, RolloffInverse, RolloffExponential:
+		return nil
+	default:
+		err := errors.Newf("settings: invalid rolloff '%s'", string(r))
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+}
+
+func (r Rolloff) String() string { return string(r) }
+
+var (
+	_ graphql.Marshaler   = (*Rolloff)(nil)
+	_ graphql.Unmarshaler = (*Rolloff)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (r Rolloff) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(r)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (r *Rolloff) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		err := errors.Newf("settings: unsupported field type %T", v)
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	if err := Rolloff(s).Validate(); err != nil {
+		return err
+	}
+	*r = Rolloff(s)
+	return nil
+}
+
+// A Service manages the Settings of players.
+type Service interface {
+	// Get returns the settings of the player with the given username.
+	//
+	// Players that have never changed their settings have the Default
+	// settings.
+	Get(ctx context.Context, username string) (*Settings, error)
+
+	// Update applies u to the settings of the player with the given
+	// username.
+	Update(ctx context.Context, username string, u *Update) (*Settings, error)
+}
+
+const (
+	// MaxHearingDistance is the maximum HearingDistance, which matches the
+	// maximum render distance of Minecraft (32 chunks).
+	MaxHearingDistance = 512.0
+
+	// MaxMuted is the maximum number of players that a player can mute.
+	MaxMuted = 256
+)
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
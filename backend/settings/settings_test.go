@@ -0,0 +1,45 @@
+package settings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateMuted(t *testing.T) {
+	tests := []struct {
+		name  string
+		muted []string
+		want  []string
+		ok    bool
+	}{
+		{name: "Empty", muted: []string{}, want: []string{}, ok: true},
+		{
+			name:  "Normalized",
+			muted: []string{" Alex", "Notch ", "Alex", "jeb_"},
+			want:  []string{"Alex", "Notch", "jeb_"},
+			ok:    true,
+		},
+		{name: "Blank", muted: []string{"  "}},
+		{name: "Self", muted: []string{"Steve"}},
+		{name: "SelfUntrimmed", muted: []string{" Steve "}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Default("Steve")
+			s.Muted = tt.muted
+			err := s.Validate()
+			if !tt.ok {
+				if err == nil {
+					t.Fatalf("Validate: expected error, got muted %q", s.Muted)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if !reflect.DeepEqual(s.Muted, tt.want) {
+				t.Errorf("Muted = %q, want %q", s.Muted, tt.want)
+			}
+		})
+	}
+}
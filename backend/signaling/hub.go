@@ -0,0 +1,242 @@
+package signaling
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/websocket"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+// A Hub is an http.Handler that serves the signaling protocol over
+// websockets.
+type Hub struct {
+	players  presence.Provider
+	upgrader websocket.Upgrader
+	cfg      HubConfig
+	logger   log.Logger
+
+	mu    sync.Mutex
+	peers map[string]*peer // by username
+}
+
+var _ http.Handler = (*Hub)(nil)
+
+// HubConfig configures a Hub.
+type HubConfig struct {
+	// CheckOrigin reports whether a websocket request should be accepted
+	// based on its Origin header. If nil, only same-origin requests are
+	// accepted.
+	CheckOrigin func(r *http.Request) bool
+
+	// PingInterval is the interval at which clients are pinged. Clients that
+	// fail to respond within two intervals are disconnected.
+	PingInterval time.Duration
+
+	// WriteTimeout is the maximum time spent writing a message to a client.
+	WriteTimeout time.Duration
+
+	// MaxMessageSize is the maximum size of a message that a client can send,
+	// in bytes.
+	MaxMessageSize int64
+
+	// SendBuffer is the number of messages that can be queued for a client.
+	// Clients that fall further behind are disconnected.
+	SendBuffer int
+
+	// Auth authenticates the session tokens that clients register with. If
+	// nil, clients can register with the username of any online player.
+	Auth auth.Service
+}
+
+// NewHub creates a Hub that only registers the usernames of players that are
+// online according to players.
+func NewHub(
+	players presence.Provider,
+	logger log.Logger,
+	opts ...func(*HubConfig),
+) *Hub {
+	cfg := HubConfig{
+		PingInterval:   30 * time.Second,
+		WriteTimeout:   10 * time.Second,
+		MaxMessageSize: 64 << 10,
+		SendBuffer:     64,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Hub{
+		players:  players,
+		upgrader: websocket.Upgrader{CheckOrigin: cfg.CheckOrigin},
+		cfg:      cfg,
+		logger:   level.NewInjector(logger, level.DebugValue()),
+		peers:    make(map[string]*peer),
+	}
+}
+
+// Registered returns the usernames of the registered clients.
+func (h *Hub) Registered() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	usernames := make([]string, 0, len(h.peers))
+	for u := range h.peers {
+		usernames = append(usernames, u)
+	}
+	return usernames
+}
+
+// ServeHTTP upgrades the request to a websocket connection, and serves the
+// signaling protocol over it until the client disconnects.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied with an error.
+		logutil.Log(logutil.WithError(h.logger, err), "failed to upgrade")
+		return
+	}
+
+	p := newPeer(conn, h.cfg)
+	go p.writeLoop()
+	defer func() {
+		p.close()
+		h.deregister(p)
+	}()
+
+	logger := log.With(h.logger, "remote", r.RemoteAddr)
+	logutil.Log(logger, "connected")
+	if err := h.readLoop(r.Context(), p, logger); err != nil {
+		if !websocket.IsCloseError(
+			err,
+			websocket.CloseNormalClosure,
+			websocket.CloseGoingAway,
+		) {
+			logutil.Log(logutil.WithError(logger, err), "connection failed")
+		}
+	}
+}
+
+func (h *Hub) readLoop(ctx context.Context, p *peer, logger log.Logger) error {
+	for {
+		var msg Message
+		if err := p.conn.ReadJSON(&msg); err != nil {
+			return err
+		}
+		switch msg.Type {
+		case TypeRegister:
+			reply := Message{Type: TypeRegistered}
+			if err := h.register(ctx, p, msg.Username, msg.Token); err != nil {
+				l := log.With(logger, "username", msg.Username)
+				logutil.Log(logutil.WithError(l, err), "failed to register")
+				reply.Error = publicMessage(err)
+				p.send(reply)
+				continue
+			}
+			logger = log.With(logger, "username", msg.Username)
+			logutil.Log(logger, "registered")
+		case TypeData:
+			if err := h.relay(p, msg.Recipient, msg.Payload); err != nil {
+				l := log.With(logger, "recipient", msg.Recipient)
+				logutil.Log(logutil.WithError(l, err), "failed to relay data")
+			}
+		default:
+			l := log.With(logger, "type", msg.Type)
+			logutil.Log(l, "ignoring message of unknown type")
+		}
+	}
+}
+
+// register registers p with username, and introduces it to the other
+// registered peers.
+//
+// If the Hub requires authentication, token must be a session token for
+// username.
+func (h *Hub) register(
+	ctx context.Context,
+	p *peer,
+	username, token string,
+) error {
+	if h.cfg.Auth != nil {
+		authenticated, err := h.cfg.Auth.Authenticate(token)
+		if err != nil {
+			return errors.Mark(err, ErrUnauthenticated)
+		}
+		if authenticated != username {
+			return errors.WithStack(ErrUnauthenticated)
+		}
+	}
+	if _, err := h.players.Get(ctx, username); err != nil {
+		if errors.Is(err, presence.ErrNotFound) {
+			return errors.WithStack(ErrNotOnline)
+		}
+		return errors.Wrap(err, "signaling: get player")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if p.username != "" {
+		return errors.WithStack(ErrAlreadyRegistered)
+	}
+	if _, ok := h.peers[username]; ok {
+		return errors.WithStack(ErrAlreadyRegistered)
+	}
+	p.username = username
+	h.peers[username] = p
+
+	// Acknowledge registration, and then tell participants about each other.
+	//
+	// Messages are queued while holding the lock, so that every peer observes
+	// registrations and deregistrations in the same order. The
+	// acknowledgement is queued first, so that clients can set up before
+	// being introduced.
+	p.send(Message{Type: TypeRegistered})
+	for other, q := range h.peers {
+		if q == p {
+			continue
+		}
+		p.send(Message{Type: TypeRegister, Username: other, Initiate: true})
+		q.send(Message{Type: TypeRegister, Username: username})
+	}
+	return nil
+}
+
+// relay sends payload from p to the peer registered with the username
+// recipient.
+func (h *Hub) relay(p *peer, recipient string, payload []byte) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if p.username == "" {
+		return errors.WithStack(ErrNotRegistered)
+	}
+	q, ok := h.peers[recipient]
+	if !ok {
+		// The recipient may have disconnected after the data was sent.
+		return errors.New("signaling: recipient not found")
+	}
+	q.send(Message{Type: TypeData, Sender: p.username, Payload: payload})
+	return nil
+}
+
+// deregister removes p, and tells the remaining peers that it has left.
+func (h *Hub) deregister(p *peer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if p.username == "" || h.peers[p.username] != p {
+		return
+	}
+	delete(h.peers, p.username)
+	for _, q := range h.peers {
+		q.send(Message{Type: TypeDeregister, Username: p.username})
+	}
+
+	l := log.With(h.logger, "username", p.username)
+	logutil.Log(l, "deregistered")
+}
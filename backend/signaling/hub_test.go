@@ -0,0 +1,256 @@
+package signaling
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/websocket"
+
+	"go.stevenxie.me/zoomcraft/backend/auth"
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// fakeProvider is a presence.Provider whose online players are fixed.
+type fakeProvider struct{ online []string }
+
+var _ presence.Provider = fakeProvider{}
+
+func (p fakeProvider) Get(_ context.Context, id string) (*presence.Entity, error) {
+	for _, u := range p.online {
+		if u == id {
+			return &presence.Entity{ID: u}, nil
+		}
+	}
+	return nil, presence.ErrNotFound
+}
+
+func (p fakeProvider) List(context.Context) ([]*presence.Entity, error) {
+	entities := make([]*presence.Entity, len(p.online))
+	for i, u := range p.online {
+		entities[i] = &presence.Entity{ID: u}
+	}
+	return entities, nil
+}
+
+// fakeAuth is an auth.Service whose session tokens are "token:<username>".
+type fakeAuth struct{ auth.Service }
+
+func (fakeAuth) Authenticate(token string) (string, error) {
+	if !strings.HasPrefix(token, "token:") {
+		return "", errors.New("invalid token")
+	}
+	return strings.TrimPrefix(token, "token:"), nil
+}
+
+func newHub(t *testing.T, opts ...func(*HubConfig)) (*Hub, *httptest.Server) {
+	t.Helper()
+	players := fakeProvider{online: []string{"Steve", "Alex", "Notch"}}
+	hub := NewHub(players, log.NewNopLogger(), opts...)
+	srv := httptest.NewServer(hub)
+	t.Cleanup(srv.Close)
+	return hub, srv
+}
+
+func dial(t *testing.T, srv *httptest.Server) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// readMessage reads the next Message from conn, failing after a second.
+func readMessage(t *testing.T, conn *websocket.Conn) Message {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	var msg Message
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+	return msg
+}
+
+// register registers conn with username, and returns the Hub's reply.
+func register(t *testing.T, conn *websocket.Conn, username, token string) Message {
+	t.Helper()
+	msg := Message{Type: TypeRegister, Username: username, Token: token}
+	if err := conn.WriteJSON(msg); err != nil {
+		t.Fatalf("write message: %v", err)
+	}
+	reply := readMessage(t, conn)
+	if reply.Type != TypeRegistered {
+		t.Fatalf("expected a %q reply, got %+v", TypeRegistered, reply)
+	}
+	return reply
+}
+
+// waitRegistered waits for the Hub's registered usernames to equal want.
+func waitRegistered(t *testing.T, hub *Hub, want ...string) {
+	t.Helper()
+	sort.Strings(want)
+	var got []string
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		got = hub.Registered()
+		sort.Strings(got)
+		if strings.Join(got, ",") == strings.Join(want, ",") {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Registered = %q, want %q", got, want)
+}
+
+func TestHubRegister(t *testing.T) {
+	hub, srv := newHub(t)
+
+	steve := dial(t, srv)
+	if reply := register(t, steve, "Steve", ""); reply.Error != "" {
+		t.Fatalf("register Steve: %s", reply.Error)
+	}
+
+	// Registered clients are introduced to each other, and the newcomer is
+	// asked to initiate the connection.
+	alex := dial(t, srv)
+	if reply := register(t, alex, "Alex", ""); reply.Error != "" {
+		t.Fatalf("register Alex: %s", reply.Error)
+	}
+	want := Message{Type: TypeRegister, Username: "Steve", Initiate: true}
+	if msg := readMessage(t, alex); msg.Type != want.Type ||
+		msg.Username != want.Username || msg.Initiate != want.Initiate {
+		t.Errorf("Alex: got %+v, want %+v", msg, want)
+	}
+	want = Message{Type: TypeRegister, Username: "Alex"}
+	if msg := readMessage(t, steve); msg.Type != want.Type ||
+		msg.Username != want.Username || msg.Initiate != want.Initiate {
+		t.Errorf("Steve: got %+v, want %+v", msg, want)
+	}
+	waitRegistered(t, hub, "Steve", "Alex")
+
+	// Data is relayed as-is, with its sender.
+	payload := json.RawMessage(`{"sdp":"offer"}`)
+	err := alex.WriteJSON(Message{
+		Type:      TypeData,
+		Recipient: "Steve",
+		Payload:   payload,
+	})
+	if err != nil {
+		t.Fatalf("write message: %v", err)
+	}
+	msg := readMessage(t, steve)
+	if msg.Type != TypeData || msg.Sender != "Alex" ||
+		string(msg.Payload) != string(payload) {
+		t.Errorf("Steve: got %+v, want data from Alex", msg)
+	}
+
+	// Clients cannot register twice, nor as players that are offline.
+	if reply := register(t, alex, "Notch", ""); reply.Error != "username already registered" {
+		t.Errorf("register twice: got error %q", reply.Error)
+	}
+	if reply := register(t, dial(t, srv), "Herobrine", ""); reply.Error != "player is not online" {
+		t.Errorf("register offline player: got error %q", reply.Error)
+	}
+}
+
+func TestHubConcurrentRegister(t *testing.T) {
+	hub, srv := newHub(t)
+
+	const n = 8
+	conns := make([]*websocket.Conn, n)
+	for i := range conns {
+		conns[i] = dial(t, srv)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		replies = make([]Message, n)
+	)
+	for i, conn := range conns {
+		wg.Add(1)
+		go func(i int, conn *websocket.Conn) {
+			defer wg.Done()
+			msg := Message{Type: TypeRegister, Username: "Steve"}
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+			conn.SetReadDeadline(time.Now().Add(time.Second))
+			conn.ReadJSON(&replies[i])
+		}(i, conn)
+	}
+	wg.Wait()
+
+	var registered, rejected int
+	for _, reply := range replies {
+		switch {
+		case reply.Type != TypeRegistered:
+			t.Errorf("expected a %q reply, got %+v", TypeRegistered, reply)
+		case reply.Error == "":
+			registered++
+		case reply.Error == "username already registered":
+			rejected++
+		default:
+			t.Errorf("unexpected error %q", reply.Error)
+		}
+	}
+	if registered != 1 || rejected != n-1 {
+		t.Errorf(
+			"expected 1 registration and %d rejections, got %d and %d",
+			n-1, registered, rejected,
+		)
+	}
+	waitRegistered(t, hub, "Steve")
+}
+
+func TestHubDeregister(t *testing.T) {
+	hub, srv := newHub(t)
+
+	steve, alex := dial(t, srv), dial(t, srv)
+	register(t, steve, "Steve", "")
+	register(t, alex, "Alex", "")
+	readMessage(t, alex)  // introduction to Steve
+	readMessage(t, steve) // introduction to Alex
+	waitRegistered(t, hub, "Steve", "Alex")
+
+	// Remaining clients are told when a client disconnects.
+	alex.Close()
+	msg := readMessage(t, steve)
+	if msg.Type != TypeDeregister || msg.Username != "Alex" {
+		t.Errorf("Steve: got %+v, want deregistration of Alex", msg)
+	}
+	waitRegistered(t, hub, "Steve")
+
+	// The username can be registered again once it is released.
+	if reply := register(t, dial(t, srv), "Alex", ""); reply.Error != "" {
+		t.Errorf("register Alex again: %s", reply.Error)
+	}
+	waitRegistered(t, hub, "Steve", "Alex")
+
+	steve.Close()
+	waitRegistered(t, hub, "Alex")
+}
+
+func TestHubAuth(t *testing.T) {
+	hub, srv := newHub(t, func(cfg *HubConfig) { cfg.Auth = fakeAuth{} })
+
+	conn := dial(t, srv)
+	for _, token := range []string{"", "bogus", "token:Alex"} {
+		if reply := register(t, conn, "Steve", token); reply.Error != "not authenticated" {
+			t.Errorf("register with token %q: got error %q", token, reply.Error)
+		}
+	}
+	if reply := register(t, conn, "Steve", "token:Steve"); reply.Error != "" {
+		t.Errorf("register with valid token: %s", reply.Error)
+	}
+	waitRegistered(t, hub, "Steve")
+}
@@ -0,0 +1,90 @@
+package signaling
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+// A peer is a client connected to a Hub.
+//
+// Messages are queued with send, and written by writeLoop, so that slow
+// clients never block the Hub.
+type peer struct {
+	conn *websocket.Conn
+	cfg  HubConfig
+
+	// username is the username that the peer registered with. It is guarded
+	// by the Hub's mutex.
+	username string
+
+	outbox    chan Message
+	done      chan types.Empty
+	closeOnce sync.Once
+}
+
+func newPeer(conn *websocket.Conn, cfg HubConfig) *peer {
+	conn.SetReadLimit(cfg.MaxMessageSize)
+
+	// Clients that stop responding to pings are disconnected when the read
+	// deadline passes.
+	deadline := 2 * cfg.PingInterval
+	conn.SetReadDeadline(time.Now().Add(deadline))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(deadline))
+	})
+
+	return &peer{
+		conn:   conn,
+		cfg:    cfg,
+		outbox: make(chan Message, cfg.SendBuffer),
+		done:   make(chan types.Empty),
+	}
+}
+
+// send queues msg to be written to the peer. If the peer's queue is full,
+// it is disconnected instead.
+func (p *peer) send(msg Message) {
+	select {
+	case p.outbox <- msg:
+	case <-p.done:
+	default:
+		p.close()
+	}
+}
+
+// close closes the peer's connection, which causes the Hub to stop reading
+// from it.
+func (p *peer) close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+		p.conn.Close()
+	})
+}
+
+func (p *peer) writeLoop() {
+	defer p.close()
+
+	ticker := time.NewTicker(p.cfg.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg := <-p.outbox:
+			p.conn.SetWriteDeadline(time.Now().Add(p.cfg.WriteTimeout))
+			if err := p.conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			deadline := time.Now().Add(p.cfg.WriteTimeout)
+			err := p.conn.WriteControl(websocket.PingMessage, nil, deadline)
+			if err != nil {
+				return
+			}
+		case <-p.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Package signaling implements a WebRTC signaling server, which introduces
+// players to each other and relays the data that they need to establish peer
+// connections.
+//
+// Clients connect to a Hub over a websocket, and exchange JSON-encoded
+// Messages with it:
+//
+//  1. A client registers with the username of an online player (and a session
+//     token for it, if the Hub requires authentication), and receives a
+//     TypeRegistered reply.
+//  2. Every pair of registered clients is introduced with TypeRegister
+//     messages, exactly one of which asks its recipient to initiate the
+//     connection.
+//  3. Clients relay arbitrary payloads to each other with TypeData messages.
+//  4. When a client disconnects, all other clients are sent a
+//     TypeDeregister message.
+package signaling
+
+import (
+	"encoding/json"
+	stderrors "errors"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// A Message is a message exchanged between a client and a Hub.
+//
+// The fields that are set depend on its Type.
+type Message struct {
+	Type MessageType `json:"type"`
+
+	// Username is the username to register with (in TypeRegister messages
+	// sent by clients), or the username of the player that registered or
+	// deregistered (in TypeRegister and TypeDeregister messages sent by a
+	// Hub).
+	Username string `json:"username,omitempty"`
+
+	// Token is the session token that authenticates a TypeRegister message
+	// sent by a client, if the Hub requires authentication (see auth.Session).
+	Token string `json:"token,omitempty"`
+
+	// Initiate reports whether the recipient of a TypeRegister message sent
+	// by a Hub should initiate the connection with the registered player.
+	Initiate bool `json:"initiate,omitempty"`
+
+	// Recipient is the username of the player that the payload of a TypeData
+	// message should be relayed to.
+	Recipient string `json:"recipient,omitempty"`
+
+	// Sender is the username of the player that sent the payload of a
+	// relayed TypeData message.
+	Sender string `json:"sender,omitempty"`
+
+	// Payload is the data relayed by a TypeData message, which is passed
+	// through as-is.
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// Error describes why registration failed, in TypeRegistered messages.
+	Error string `json:"error,omitempty"`
+}
+
+// A MessageType identifies the kind of a Message.
+type MessageType string
+
+// The set of valid MessageTypes.
+const (
+	TypeRegister   MessageType = "register"
+	TypeRegistered MessageType = "registered"
+	TypeData       MessageType = "data"
+	TypeDeregister MessageType = "deregister"
+)
+
+var (
+	// ErrAlreadyRegistered is returned when a client registers with a
+	// username that is already in use, or registers more than once.
+	ErrAlreadyRegistered = stderrors.New("signaling: already registered")
+
+	// ErrNotOnline is returned when a client registers with the username of a
+	// player that is not online.
+	ErrNotOnline = stderrors.New("signaling: player is not online")
+
+	// ErrNotRegistered is returned when a client relays data before
+	// registering.
+	ErrNotRegistered = stderrors.New("signaling: not registered")
+
+	// ErrUnauthenticated is returned when a client registers without a valid
+	// session token for the username, when the Hub requires authentication.
+	ErrUnauthenticated = stderrors.New("signaling: not authenticated")
+)
+
+// publicMessage describes err in a way that is safe to show to clients.
+func publicMessage(err error) string {
+	switch {
+	case errors.Is(err, ErrAlreadyRegistered):
+		return "username already registered"
+	case errors.Is(err, ErrNotOnline):
+		return "player is not online"
+	case errors.Is(err, ErrNotRegistered):
+		return "not registered"
+	case errors.Is(err, ErrUnauthenticated):
+		return "not authenticated"
+	case errors.Is(err, presence.ErrUnavailable):
+		return "players are unavailable"
+	default:
+		return "internal error"
+	}
+}
@@ -0,0 +1,103 @@
+package store_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/rooms"
+	"go.stevenxie.me/zoomcraft/backend/settings"
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/zones"
+)
+
+// The documents kept in a Store must round-trip through BSON (for the MongoDB
+// store) under the same field names as in JSON (for the file store).
+func TestDocumentsBSON(t *testing.T) {
+	docs := []interface{}{
+		&rooms.Room{
+			ID:      types.NewID(),
+			Name:    "Lobby",
+			Mode:    rooms.ModeProximity,
+			Members: []string{"Steve"},
+			Area: &rooms.Area{
+				Space: "minecraft:overworld",
+				Min:   presence.Position{X: -8, Y: 60, Z: -8},
+				Max:   presence.Position{X: 8, Y: 80, Z: 8},
+			},
+		},
+		&zones.Zone{
+			ID:          types.NewID(),
+			Name:        "Stage",
+			Space:       "minecraft:overworld",
+			Attenuation: zones.AttenuationBroadcast,
+			Box: &zones.Box{
+				Min: presence.Position{X: 0, Y: 60, Z: 0},
+				Max: presence.Position{X: 4, Y: 70, Z: 4},
+			},
+		},
+		&zones.Zone{
+			ID:          types.NewID(),
+			Name:        "Library",
+			Space:       "minecraft:overworld",
+			Attenuation: zones.AttenuationMuffled,
+			Polygon: &zones.Polygon{
+				Vertices: []zones.Vertex{{X: 0, Z: 0}, {X: 10, Z: 0}, {X: 5, Z: 8}},
+				MinY:     60,
+				MaxY:     72,
+			},
+		},
+		&settings.Settings{
+			Username:        "Steve",
+			HearingDistance: 30,
+			Rolloff:         settings.RolloffLinear,
+			Muted:           []string{"Alex"},
+			PushToTalk:      true,
+		},
+	}
+	for _, doc := range docs {
+		name := reflect.TypeOf(doc).Elem().String()
+		t.Run(name, func(t *testing.T) {
+			data, err := bson.Marshal(doc)
+			if err != nil {
+				t.Fatalf("marshal BSON: %v", err)
+			}
+			decoded := reflect.New(reflect.TypeOf(doc).Elem()).Interface()
+			if err = bson.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("unmarshal BSON: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, doc) {
+				t.Errorf("BSON round-trip = %+v, want %+v", decoded, doc)
+			}
+
+			var bsonFields bson.M
+			if err = bson.Unmarshal(data, &bsonFields); err != nil {
+				t.Fatalf("unmarshal BSON fields: %v", err)
+			}
+			var jsonFields map[string]interface{}
+			data, err = json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("marshal JSON: %v", err)
+			}
+			if err = json.Unmarshal(data, &jsonFields); err != nil {
+				t.Fatalf("unmarshal JSON fields: %v", err)
+			}
+			if b, j := keys(bsonFields), keys(jsonFields); !reflect.DeepEqual(b, j) {
+				t.Errorf("BSON fields = %q, want JSON fields %q", b, j)
+			}
+		})
+	}
+}
+
+func keys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// fileStore is a Store that keeps all documents in memory, and saves them to
+// a single JSON file whenever they change.
+type fileStore struct {
+	path string
+
+	mu          sync.Mutex
+	collections map[string]map[string]json.RawMessage
+}
+
+var _ Store = (*fileStore)(nil)
+
+// NewFileStore creates a Store that is backed by the JSON file at path, which
+// is created if it does not exist.
+//
+// The file is rewritten in its entirety on every change, so it is only
+// suitable for small amounts of data. If path is empty, documents are only
+// kept in memory.
+func NewFileStore(path string) (Store, error) {
+	s := &fileStore{
+		path:        path,
+		collections: make(map[string]map[string]json.RawMessage),
+	}
+	if path == "" {
+		return s, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, errors.Wrap(err, "store: read file")
+	}
+	if err = json.Unmarshal(data, &s.collections); err != nil {
+		return nil, errors.Wrap(err, "store: decode file")
+	}
+	return s, nil
+}
+
+func (s *fileStore) Collection(name string) Collection {
+	return &fileCollection{store: s, name: name}
+}
+
+func (s *fileStore) Close(context.Context) error { return nil }
+
+// save writes all collections to s.path, by way of a temporary file so that
+// the existing file is never left partially written.
+//
+// s.mu must be held by the caller.
+func (s *fileStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s.collections, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "store: encode file")
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "store: create temporary file")
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Wrap(err, "store: write temporary file")
+	}
+	return errors.Wrap(os.Rename(f.Name(), s.path), "store: replace file")
+}
+
+type fileCollection struct {
+	store *fileStore
+	name  string
+}
+
+var _ Collection = (*fileCollection)(nil)
+
+func (c *fileCollection) Get(_ context.Context, key string, doc interface{}) error {
+	c.store.mu.Lock()
+	raw, ok := c.store.collections[c.name][key]
+	c.store.mu.Unlock()
+
+	if !ok {
+		return errors.WithStack(ErrNotFound)
+	}
+	return errors.Wrap(json.Unmarshal(raw, doc), "store: decode document")
+}
+
+func (c *fileCollection) List(_ context.Context, docs interface{}) error {
+	c.store.mu.Lock()
+	var (
+		docsByKey = c.store.collections[c.name]
+		keys      = make([]string, 0, len(docsByKey))
+	)
+	for key := range docsByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	raws := make([]json.RawMessage, len(keys))
+	for i, key := range keys {
+		raws[i] = docsByKey[key]
+	}
+	c.store.mu.Unlock()
+
+	// Decode the documents as a single JSON array, so that docs may be a
+	// pointer to any kind of slice.
+	data, err := json.Marshal(raws)
+	if err != nil {
+		return errors.Wrap(err, "store: encode documents")
+	}
+	return errors.Wrap(json.Unmarshal(data, docs), "store: decode documents")
+}
+
+func (c *fileCollection) Put(_ context.Context, key string, doc interface{}) error {
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return errors.Wrap(err, "store: encode document")
+	}
+
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	docsByKey, ok := c.store.collections[c.name]
+	if !ok {
+		docsByKey = make(map[string]json.RawMessage)
+		c.store.collections[c.name] = docsByKey
+	}
+	prev, existed := docsByKey[key]
+	docsByKey[key] = raw
+	if err := c.store.save(); err != nil {
+		// Roll back, so that memory matches the file.
+		if existed {
+			docsByKey[key] = prev
+		} else {
+			delete(docsByKey, key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *fileCollection) Delete(_ context.Context, key string) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+
+	docsByKey := c.store.collections[c.name]
+	prev, ok := docsByKey[key]
+	if !ok {
+		return nil
+	}
+	delete(docsByKey, key)
+	if err := c.store.save(); err != nil {
+		docsByKey[key] = prev
+		return err
+	}
+	return nil
+}
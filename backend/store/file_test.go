@@ -0,0 +1,234 @@
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+type doc struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// tempDir creates a temporary directory, which is removed after the test.
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatalf("create temporary directory: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestFileCollection(t *testing.T) {
+	s, err := NewFileStore("")
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	var (
+		ctx   = context.Background()
+		coll  = s.Collection("docs")
+		other = s.Collection("other")
+	)
+
+	var got doc
+	if err = coll.Get(ctx, "a", &got); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get from empty collection: expected ErrNotFound, got %v", err)
+	}
+
+	// Documents are listed by key, regardless of when they were put.
+	for _, key := range []string{"c", "a", "b"} {
+		if err = coll.Put(ctx, key, doc{Name: key}); err != nil {
+			t.Fatalf("Put(%q): %v", key, err)
+		}
+	}
+	if err = coll.Put(ctx, "a", doc{Name: "a", Count: 2}); err != nil {
+		t.Fatalf("Put(a): %v", err)
+	}
+	if err = other.Put(ctx, "z", doc{Name: "z"}); err != nil {
+		t.Fatalf("Put(z): %v", err)
+	}
+
+	if err = coll.Get(ctx, "a", &got); err != nil {
+		t.Fatalf("Get(a): %v", err)
+	}
+	if want := (doc{Name: "a", Count: 2}); got != want {
+		t.Errorf("Get(a) = %+v, want %+v", got, want)
+	}
+	var docs []doc
+	if err = coll.List(ctx, &docs); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	want := []doc{{Name: "a", Count: 2}, {Name: "b"}, {Name: "c"}}
+	if !reflect.DeepEqual(docs, want) {
+		t.Errorf("List = %+v, want %+v", docs, want)
+	}
+
+	// Deleting missing documents is a no-op.
+	if err = coll.Delete(ctx, "b"); err != nil {
+		t.Fatalf("Delete(b): %v", err)
+	}
+	if err = coll.Delete(ctx, "b"); err != nil {
+		t.Fatalf("Delete(b) again: %v", err)
+	}
+	if err = coll.Get(ctx, "b", &got); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(b) after Delete: expected ErrNotFound, got %v", err)
+	}
+	docs = nil
+	if err = coll.List(ctx, &docs); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if want = []doc{{Name: "a", Count: 2}, {Name: "c"}}; !reflect.DeepEqual(docs, want) {
+		t.Errorf("List after Delete = %+v, want %+v", docs, want)
+	}
+
+	// Lists of empty collections are empty, rather than nil.
+	docs = nil
+	if err = s.Collection("empty").List(ctx, &docs); err != nil {
+		t.Fatalf("List empty: %v", err)
+	}
+	if docs == nil || len(docs) != 0 {
+		t.Errorf("List empty = %#v, want an empty slice", docs)
+	}
+}
+
+// Documents keyed by types.NewID are listed in the order they were created.
+func TestFileCollectionIDOrder(t *testing.T) {
+	s, err := NewFileStore("")
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	var (
+		ctx  = context.Background()
+		coll = s.Collection("docs")
+		want []doc
+	)
+	for i := 0; i < 20; i++ {
+		d := doc{Name: types.NewID().Hex(), Count: i}
+		if err = coll.Put(ctx, d.Name, d); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		want = append(want, d)
+	}
+	var docs []doc
+	if err = coll.List(ctx, &docs); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !reflect.DeepEqual(docs, want) {
+		t.Errorf("List = %+v, want %+v", docs, want)
+	}
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	path := filepath.Join(tempDir(t), "store.json")
+	ctx := context.Background()
+
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	coll := s.Collection("docs")
+	for _, d := range []doc{{Name: "b", Count: 1}, {Name: "a", Count: 2}} {
+		if err = coll.Put(ctx, d.Name, d); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	if err = coll.Delete(ctx, "b"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err = s.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Reopening the store restores its documents from the file.
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	var docs []doc
+	if err = s.Collection("docs").List(ctx, &docs); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if want := []doc{{Name: "a", Count: 2}}; !reflect.DeepEqual(docs, want) {
+		t.Errorf("List after reopen = %+v, want %+v", docs, want)
+	}
+
+	// Corrupt files are reported, rather than overwritten.
+	if err = ioutil.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatalf("corrupt file: %v", err)
+	}
+	if _, err = NewFileStore(path); err == nil {
+		t.Error("NewFileStore: expected error for corrupt file")
+	}
+}
+
+func TestFileStoreRollback(t *testing.T) {
+	var (
+		dir  = filepath.Join(tempDir(t), "data")
+		path = filepath.Join(dir, "store.json")
+		ctx  = context.Background()
+	)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("create directory: %v", err)
+	}
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	coll := s.Collection("docs")
+	if err = coll.Put(ctx, "a", doc{Name: "a", Count: 1}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	saved, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+
+	// Removing the directory makes every write fail (even as root).
+	if err = os.RemoveAll(dir); err != nil {
+		t.Fatalf("remove directory: %v", err)
+	}
+	if err = coll.Put(ctx, "a", doc{Name: "a", Count: 2}); err == nil {
+		t.Fatal("Put: expected error")
+	}
+	if err = coll.Put(ctx, "b", doc{Name: "b"}); err == nil {
+		t.Fatal("Put: expected error")
+	}
+	if err = coll.Delete(ctx, "a"); err == nil {
+		t.Fatal("Delete: expected error")
+	}
+
+	// Failed writes are rolled back, so memory still matches the file.
+	var docs []doc
+	if err = coll.List(ctx, &docs); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if want := []doc{{Name: "a", Count: 1}}; !reflect.DeepEqual(docs, want) {
+		t.Errorf("List after failed writes = %+v, want %+v", docs, want)
+	}
+
+	// Writes succeed again once the directory is restored, and do not
+	// include the failed writes.
+	if err = os.Mkdir(dir, 0755); err != nil {
+		t.Fatalf("restore directory: %v", err)
+	}
+	if err = coll.Put(ctx, "a", doc{Name: "a", Count: 1}); err != nil {
+		t.Fatalf("Put after restore: %v", err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if string(data) != string(saved) {
+		t.Errorf("file = %s, want %s", data, saved)
+	}
+}
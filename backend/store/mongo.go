@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoStore is a Store that is backed by a MongoDB database.
+type mongoStore struct {
+	client *mongo.Client
+	db     *mongo.Database
+}
+
+var _ Store = (*mongoStore)(nil)
+
+// NewMongoStore creates a Store that is backed by the given database of the
+// MongoDB deployment at uri.
+//
+// Each Collection is stored as a MongoDB collection of the same name, whose
+// documents use their keys as their "_id".
+func NewMongoStore(ctx context.Context, uri, database string) (Store, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, errors.Wrap(err, "store: connect to MongoDB")
+	}
+	if err = client.Ping(ctx, nil); err != nil {
+		client.Disconnect(ctx)
+		return nil, errors.Wrap(err, "store: ping MongoDB")
+	}
+	return &mongoStore{
+		client: client,
+		db:     client.Database(database),
+	}, nil
+}
+
+func (s *mongoStore) Collection(name string) Collection {
+	return mongoCollection{s.db.Collection(name)}
+}
+
+func (s *mongoStore) Close(ctx context.Context) error {
+	return errors.Wrap(s.client.Disconnect(ctx), "store: disconnect from MongoDB")
+}
+
+type mongoCollection struct{ coll *mongo.Collection }
+
+var _ Collection = (*mongoCollection)(nil)
+
+func (c mongoCollection) Get(ctx context.Context, key string, doc interface{}) error {
+	err := c.coll.FindOne(ctx, bson.M{"_id": key}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return errors.WithStack(ErrNotFound)
+	}
+	return errors.Wrap(err, "store: find document")
+}
+
+func (c mongoCollection) List(ctx context.Context, docs interface{}) error {
+	cur, err := c.coll.Find(
+		ctx, bson.M{},
+		options.Find().SetSort(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return errors.Wrap(err, "store: find documents")
+	}
+	return errors.Wrap(cur.All(ctx, docs), "store: decode documents")
+}
+
+func (c mongoCollection) Put(ctx context.Context, key string, doc interface{}) error {
+	// Upserted documents take their "_id" from the filter.
+	_, err := c.coll.ReplaceOne(
+		ctx, bson.M{"_id": key}, doc,
+		options.Replace().SetUpsert(true),
+	)
+	return errors.Wrap(err, "store: replace document")
+}
+
+func (c mongoCollection) Delete(ctx context.Context, key string) error {
+	_, err := c.coll.DeleteOne(ctx, bson.M{"_id": key})
+	return errors.Wrap(err, "store: delete document")
+}
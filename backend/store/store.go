@@ -0,0 +1,51 @@
+// Package store persists the state of the backend (i.e. rooms, zones, and
+// player settings), so that it survives restarts.
+//
+// A Store is a set of named Collections, each of which holds documents of a
+// single kind. Services keep their state in memory, and write changes through
+// to a Collection; they only read from it when they start.
+package store
+
+import (
+	"context"
+	stderrors "errors"
+)
+
+// A Store is a set of named Collections.
+type Store interface {
+	// Collection returns the collection with the given name, which is created
+	// when a document is first put into it.
+	Collection(name string) Collection
+
+	// Close releases the resources held by the store.
+	Close(ctx context.Context) error
+}
+
+// A Collection is a repository of documents of a single kind, keyed by
+// string.
+//
+// Documents are encoded as JSON or BSON (depending on the Store), so their
+// types must round-trip through both, and should declare matching json and
+// bson struct tags.
+type Collection interface {
+	// Get decodes the document with the given key into doc.
+	//
+	// It returns ErrNotFound if there is no such document.
+	Get(ctx context.Context, key string, doc interface{}) error
+
+	// List decodes all documents, ordered by key, into the slice that docs
+	// points to.
+	//
+	// Keys generated by types.NewID sort by creation time, so documents keyed
+	// by them are listed in the order that they were created.
+	List(ctx context.Context, docs interface{}) error
+
+	// Put stores doc under the given key, replacing any existing document.
+	Put(ctx context.Context, key string, doc interface{}) error
+
+	// Delete deletes the document with the given key, if it exists.
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrNotFound is returned when a document could not be found.
+var ErrNotFound = stderrors.New("store: not found")
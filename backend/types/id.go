@@ -2,8 +2,13 @@ package types
 
 import (
 	"encoding"
+	"io"
+	"net/http"
+	"strconv"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
@@ -46,6 +51,33 @@ func (id *ID) UnmarshalText(text []byte) (err error) {
 	return err
 }
 
+var (
+	_ graphql.Marshaler   = (*ID)(nil)
+	_ graphql.Unmarshaler = (*ID)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (id ID) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(id.Hex()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (id *ID) UnmarshalGQL(v interface{}) (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.WithDetail(err, "Failed to parse ID.")
+			err = exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+		}
+	}()
+
+	s, ok := v.(string)
+	if !ok {
+		return errors.Newf("core: unsupported field type %T", v)
+	}
+	*id, err = ParseID(s)
+	return err
+}
+
 var (
 	_ bson.ValueMarshaler   = (*ID)(nil)
 	_ bson.ValueUnmarshaler = (*ID)(nil)
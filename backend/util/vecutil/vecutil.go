@@ -0,0 +1,181 @@
+// Package vecutil contains helpers for vector-like types (i.e. positions and
+// orientations), which support the following representations:
+//
+//   - lists of numbers, i.e. [1.5, 64, -3] (or a string thereof);
+//   - objects keyed by component, i.e. {"x": 1.5, "y": 64, "z": -3};
+//   - command syntax, i.e. "1.5 64 -3".
+package vecutil
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ParseComponents parses a vector with the named components from s, which may
+// be in list or command syntax.
+func ParseComponents(s string, names []string, bitSize int) ([]float64, error) {
+	s = strings.TrimSpace(s)
+	s = strings.Trim(s, "[](){}")
+
+	var fields []string
+	if strings.IndexByte(s, ',') >= 0 {
+		fields = strings.Split(s, ",")
+	} else {
+		fields = strings.Fields(s)
+	}
+	if len(fields) != len(names) {
+		return nil, errors.Newf("vecutil: expected %d parts", len(names))
+	}
+
+	parts := make([]float64, len(fields))
+	for i, f := range fields {
+		f = strings.TrimSpace(f)
+		if strings.HasPrefix(f, "~") || strings.HasPrefix(f, "^") {
+			return nil, errors.Newf(
+				"vecutil: relative coordinate '%s' is not supported", f,
+			)
+		}
+		v, err := parseComponent(f, bitSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "part %d", i)
+		}
+		parts[i] = v
+	}
+	return parts, nil
+}
+
+func parseComponent(s string, bitSize int) (float64, error) {
+	v, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, err
+	}
+	if !isFinite(v) {
+		return 0, errors.Newf("vecutil: non-finite value '%s'", s)
+	}
+	return v, nil
+}
+
+func isFinite(v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) }
+
+// CheckFinite returns an error if any of parts is NaN or infinite, since they
+// cannot be represented in JSON (nor parsed from any other representation).
+func CheckFinite(parts []float64) error {
+	for i, p := range parts {
+		if !isFinite(p) {
+			return errors.Newf("vecutil: part %d is non-finite (%v)", i, p)
+		}
+	}
+	return nil
+}
+
+// ClampFinite returns a copy of parts in which NaNs are replaced by zero, and
+// infinities by the largest finite values of the given bit size.
+func ClampFinite(parts []float64, bitSize int) []float64 {
+	max := math.MaxFloat64
+	if bitSize == 32 {
+		max = math.MaxFloat32
+	}
+	clamped := make([]float64, len(parts))
+	for i, p := range parts {
+		switch {
+		case math.IsNaN(p):
+			clamped[i] = 0
+		case p > max:
+			clamped[i] = max
+		case p < -max:
+			clamped[i] = -max
+		default:
+			clamped[i] = p
+		}
+	}
+	return clamped
+}
+
+// DecodeComponents decodes a vector with the named components from v, which
+// is a value decoded from JSON or GraphQL input.
+func DecodeComponents(v interface{}, names []string, bitSize int) ([]float64, error) {
+	switch value := v.(type) {
+	case string:
+		return ParseComponents(value, names, bitSize)
+	case []interface{}:
+		if len(value) != len(names) {
+			return nil, errors.Newf("vecutil: expected %d parts", len(names))
+		}
+		parts := make([]float64, len(value))
+		for i, v := range value {
+			f, err := decodeComponent(v, bitSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "part %d", i)
+			}
+			parts[i] = f
+		}
+		return parts, nil
+	case map[string]interface{}:
+		if len(value) != len(names) {
+			return nil, errors.Newf("vecutil: expected %d fields", len(names))
+		}
+		parts := make([]float64, len(names))
+		for i, name := range names {
+			v, ok := value[name]
+			if !ok {
+				return nil, errors.Newf("vecutil: missing field '%s'", name)
+			}
+			f, err := decodeComponent(v, bitSize)
+			if err != nil {
+				return nil, errors.Wrapf(err, "field '%s'", name)
+			}
+			parts[i] = f
+		}
+		return parts, nil
+	default:
+		return nil, errors.Newf("vecutil: unsupported field type %T", value)
+	}
+}
+
+func decodeComponent(v interface{}, bitSize int) (float64, error) {
+	switch value := v.(type) {
+	case float64:
+		if !isFinite(value) {
+			return 0, errors.Newf("vecutil: non-finite value %v", value)
+		}
+		return value, nil
+	case int:
+		return float64(value), nil
+	case int64:
+		return float64(value), nil
+	case json.Number:
+		return parseComponent(string(value), bitSize)
+	case string:
+		return parseComponent(value, bitSize)
+	default:
+		return 0, errors.Newf("vecutil: unsupported part type %T", value)
+	}
+}
+
+// UnmarshalComponents decodes a vector with the named components from JSON.
+func UnmarshalComponents(data []byte, names []string, bitSize int) ([]float64, error) {
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return DecodeComponents(v, names, bitSize)
+}
+
+// FormatComponents formats parts as a list, with the given separator. Each
+// part is formatted with the minimum precision needed to represent it exactly.
+func FormatComponents(parts []float64, sep string, bitSize int) string {
+	var b strings.Builder
+	for i, p := range parts {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(strconv.FormatFloat(p, 'f', -1, bitSize))
+	}
+	return b.String()
+}
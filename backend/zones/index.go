@@ -0,0 +1,174 @@
+package zones
+
+import (
+	"math"
+	"sort"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+// cellSize is the width of the cells of an Index, in world units.
+//
+// It matches the width of a Minecraft chunk, which is roughly the size of a
+// room in a typical build.
+const cellSize = 16
+
+// maxCells is the maximum number of cells that a zone is bucketed into.
+// Larger zones are tested on every lookup instead.
+const maxCells = 1024
+
+// An Index is an immutable spatial index of zones, which locates the zone
+// that a position is within.
+//
+// Zones are bucketed into a grid of horizontal cells, so that lookups only
+// test the zones that overlap a single cell.
+type Index struct {
+	cells map[cellKey][]entry
+	large map[string][]entry // by space
+}
+
+type cellKey struct {
+	Space string
+	X, Z  int64
+}
+
+// An entry is a zone in an Index, along with its (precomputed) volume.
+type entry struct {
+	zone   *Zone
+	volume float64
+}
+
+func cellOf(x float64) int64 { return int64(math.Floor(x / cellSize)) }
+
+// NewIndex creates an Index of zones.
+//
+// Where zones overlap, the zone with the smaller volume takes precedence, so
+// that zones nested within other zones are found.
+func NewIndex(zones []*Zone) *Index {
+	idx := Index{
+		cells: make(map[cellKey][]entry),
+		large: make(map[string][]entry),
+	}
+	for _, z := range zones {
+		var (
+			b              = z.bounds()
+			minX, maxX     = cellOf(b.Min.X), cellOf(b.Max.X)
+			minZ, maxZ     = cellOf(b.Min.Z), cellOf(b.Max.Z)
+			e              = entry{zone: z, volume: z.volume()}
+			width, breadth = maxX - minX + 1, maxZ - minZ + 1
+		)
+		if width > maxCells || breadth > maxCells || width*breadth > maxCells {
+			idx.large[z.Space] = append(idx.large[z.Space], e)
+			continue
+		}
+		for cx := minX; cx <= maxX; cx++ {
+			for cz := minZ; cz <= maxZ; cz++ {
+				key := cellKey{Space: z.Space, X: cx, Z: cz}
+				idx.cells[key] = append(idx.cells[key], e)
+			}
+		}
+	}
+	for _, entries := range idx.cells {
+		sortEntries(entries)
+	}
+	for _, entries := range idx.large {
+		sortEntries(entries)
+	}
+	return &idx
+}
+
+func sortEntries(entries []entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].volume < entries[j].volume
+	})
+}
+
+// Lookup returns the zone that pos is within in the given space, or nil if
+// it is not within a zone.
+func (idx *Index) Lookup(space string, pos presence.Position) *Zone {
+	// Entries are sorted by volume, so the first entry of each list that
+	// contains pos is the smallest such zone in that list.
+	var best *entry
+	for _, entries := range [][]entry{
+		idx.cells[cellKey{Space: space, X: cellOf(pos.X), Z: cellOf(pos.Z)}],
+		idx.large[space],
+	} {
+		for i := range entries {
+			e := &entries[i]
+			if best != nil && e.volume >= best.volume {
+				break
+			}
+			if e.zone.Contains(pos) {
+				best = e
+				break
+			}
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.zone
+}
+
+// Locate returns the zone that e is within, or nil if it is not within a
+// zone (or its position is unknown).
+func (idx *Index) Locate(e *presence.Entity) *Zone {
+	if e.PositionUnknown {
+		return nil
+	}
+	return idx.Lookup(e.Space, e.Position)
+}
+
+// Neighbors returns the neighbors of listener within maxDistance that it can
+// hear, given the zones that they are in.
+//
+// Sound between entities in different zones (or between an entity in a zone
+// and one outside of it) must cross the boundaries of those zones: it is
+// blocked by isolated and broadcast zones, and muffled by muffled zones.
+// Entities in the same broadcast zone hear each other regardless of distance.
+func Neighbors(
+	idx *Index,
+	listener *presence.Entity,
+	entities []*presence.Entity,
+	maxDistance float64,
+) []*presence.Neighbor {
+	var (
+		zone      = idx.Locate(listener)
+		near, far []*presence.Entity
+		muffled   = make(map[string]bool)
+	)
+	for _, e := range entities {
+		other := idx.Locate(e)
+		if other == zone {
+			if zone != nil && zone.Attenuation == AttenuationBroadcast {
+				far = append(far, e)
+			} else {
+				near = append(near, e)
+			}
+			continue
+		}
+		if blocks(zone) || blocks(other) {
+			continue
+		}
+		near = append(near, e)
+		muffled[e.ID] = true
+	}
+
+	neighbors := append(
+		presence.Neighbors(listener, near, maxDistance),
+		presence.Neighbors(listener, far, math.Inf(1))...,
+	)
+	for _, n := range neighbors {
+		n.Muffled = muffled[n.Entity.ID]
+	}
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].Distance < neighbors[j].Distance
+	})
+	return neighbors
+}
+
+// blocks reports whether sound is blocked from crossing the boundary of z. A
+// nil zone (i.e. the open world) has no boundary.
+func blocks(z *Zone) bool {
+	return z != nil && z.Attenuation != AttenuationMuffled
+}
@@ -0,0 +1,224 @@
+package zones
+
+import (
+	"math"
+	"testing"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+func box(name string, att Attenuation, min, max presence.Position) *Zone {
+	return &Zone{
+		Name:        name,
+		Space:       "overworld",
+		Attenuation: att,
+		Box:         &Box{Min: min, Max: max},
+	}
+}
+
+func TestIndexLookup(t *testing.T) {
+	var (
+		building = box("building", AttenuationMuffled, pos(0, 0, 0), pos(100, 20, 100))
+		office   = box("office", AttenuationIsolated, pos(10, 0, 10), pos(30, 10, 30))
+		booth    = box("booth", AttenuationIsolated, pos(12, 0, 12), pos(14, 3, 14))
+		cellar   = box("cellar", AttenuationMuffled, pos(-17, -10, -17), pos(-15, 0, -15))
+		world    = box("world", AttenuationMuffled, pos(-1e5, 0, -1e5), pos(1e5, 256, 1e5))
+		yard     = &Zone{
+			Name:        "yard",
+			Space:       "overworld",
+			Attenuation: AttenuationMuffled,
+			Polygon: &Polygon{
+				Vertices: []Vertex{{100, 0}, {140, 0}, {100, 40}},
+				MaxY:     20,
+			},
+		}
+		nether = box("nether", AttenuationMuffled, pos(0, 0, 0), pos(10, 10, 10))
+	)
+	nether.Space = "nether"
+	idx := NewIndex([]*Zone{world, building, booth, office, cellar, yard, nether})
+
+	tests := []struct {
+		name  string
+		space string
+		p     presence.Position
+		want  *Zone
+	}{
+		{name: "building", p: pos(50, 5, 50), want: building},
+		{name: "nested", p: pos(20, 5, 20), want: office},
+		{name: "doubly nested", p: pos(13, 1, 13), want: booth},
+		{name: "nested edge", p: pos(30, 5, 30), want: office},
+		{name: "above nested", p: pos(20, 15, 20), want: building},
+		{name: "shared edge", p: pos(100, 5, 20), want: yard},
+		{name: "polygon", p: pos(110, 5, 10), want: yard},
+		{name: "beyond polygon", p: pos(130, 5, 30), want: world},
+		{name: "negative cell", p: pos(-16, -5, -16), want: cellar},
+		{name: "negative cell edge", p: pos(-15, -5, -17), want: cellar},
+		{name: "outside negative cell", p: pos(-14.9, -5, -16)},
+		{name: "large zone", p: pos(-5e4, 64, 5e4), want: world},
+		{name: "outside large zone", p: pos(-5e4, 300, 5e4)},
+		{name: "other space", space: "nether", p: pos(5, 5, 5), want: nether},
+		{name: "unknown space", space: "end", p: pos(5, 5, 5)},
+	}
+	for _, test := range tests {
+		space := test.space
+		if space == "" {
+			space = "overworld"
+		}
+		if got := idx.Lookup(space, test.p); got != test.want {
+			t.Errorf("%s: expected %v, got %v", test.name, zoneName(test.want), zoneName(got))
+		}
+	}
+
+	unknown := &presence.Entity{Space: "overworld", Position: pos(20, 5, 20), PositionUnknown: true}
+	if z := idx.Locate(unknown); z != nil {
+		t.Errorf("expected an entity with an unknown position to be in no zone, got %v", z.Name)
+	}
+}
+
+func zoneName(z *Zone) string {
+	if z == nil {
+		return "<none>"
+	}
+	return z.Name
+}
+
+func TestNeighbors(t *testing.T) {
+	var (
+		isolated  = box("isolated", AttenuationIsolated, pos(0, 0, 0), pos(10, 10, 10))
+		muffled   = box("muffled", AttenuationMuffled, pos(20, 0, 0), pos(30, 10, 10))
+		broadcast = box("broadcast", AttenuationBroadcast, pos(100, 0, 0), pos(200, 10, 10))
+		muffled2  = box("muffled2", AttenuationMuffled, pos(31, 0, 0), pos(40, 10, 10))
+		idx       = NewIndex([]*Zone{isolated, muffled, broadcast, muffled2})
+	)
+	entity := func(id string, x float64) *presence.Entity {
+		return &presence.Entity{ID: id, Space: "overworld", Position: pos(x, 5, 5)}
+	}
+	tests := []struct {
+		name     string
+		listener *presence.Entity
+		entities []*presence.Entity
+		want     []heard
+	}{
+		{
+			name:     "open world",
+			listener: entity("a", 50),
+			entities: []*presence.Entity{entity("b", 55), entity("c", 60), entity("far", 80)},
+			want:     []heard{{id: "b"}, {id: "c"}},
+		},
+		{
+			name:     "same muffled zone",
+			listener: entity("a", 21),
+			entities: []*presence.Entity{entity("b", 29)},
+			want:     []heard{{id: "b"}},
+		},
+		{
+			name:     "into muffled zone",
+			listener: entity("a", 15),
+			entities: []*presence.Entity{entity("b", 25)},
+			want:     []heard{{id: "b", muffled: true}},
+		},
+		{
+			name:     "out of muffled zone",
+			listener: entity("a", 25),
+			entities: []*presence.Entity{entity("b", 15)},
+			want:     []heard{{id: "b", muffled: true}},
+		},
+		{
+			name:     "between muffled zones",
+			listener: entity("a", 29),
+			entities: []*presence.Entity{entity("b", 33)},
+			want:     []heard{{id: "b", muffled: true}},
+		},
+		{
+			name:     "muffled beyond hearing distance",
+			listener: entity("a", 21),
+			entities: []*presence.Entity{entity("b", 50)},
+		},
+		{
+			name:     "same isolated zone",
+			listener: entity("a", 2),
+			entities: []*presence.Entity{entity("b", 8)},
+			want:     []heard{{id: "b"}},
+		},
+		{
+			name:     "into isolated zone",
+			listener: entity("a", 15),
+			entities: []*presence.Entity{entity("b", 8), entity("c", 12)},
+			want:     []heard{{id: "c"}},
+		},
+		{
+			name:     "out of isolated zone",
+			listener: entity("a", 8),
+			entities: []*presence.Entity{entity("b", 15), entity("c", 25)},
+		},
+		{
+			name:     "same broadcast zone",
+			listener: entity("a", 110),
+			entities: []*presence.Entity{entity("b", 190), entity("c", 120)},
+			want:     []heard{{id: "c"}, {id: "b"}},
+		},
+		{
+			name:     "into broadcast zone",
+			listener: entity("a", 95),
+			entities: []*presence.Entity{entity("b", 105), entity("c", 90)},
+			want:     []heard{{id: "c"}},
+		},
+		{
+			name:     "out of broadcast zone",
+			listener: entity("a", 105),
+			entities: []*presence.Entity{entity("b", 95), entity("c", 115)},
+			want:     []heard{{id: "c"}},
+		},
+		{
+			name:     "sorted by distance across zones",
+			listener: entity("a", 19),
+			entities: []*presence.Entity{entity("b", 29), entity("c", 16), entity("d", 21)},
+			want: []heard{
+				{id: "d", muffled: true},
+				{id: "c"},
+				{id: "b", muffled: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		neighbors := Neighbors(idx, test.listener, test.entities, presence.DefaultHearingDistance)
+		got := make([]heard, len(neighbors))
+		for i, n := range neighbors {
+			got[i] = heard{id: n.Entity.ID, muffled: n.Muffled}
+		}
+		if !equalHeard(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.want, got)
+		}
+		for i := 1; i < len(neighbors); i++ {
+			if neighbors[i-1].Distance > neighbors[i].Distance {
+				t.Errorf("%s: neighbors are not sorted by distance", test.name)
+				break
+			}
+		}
+	}
+
+	// Entities in a broadcast zone are heard at any distance.
+	listener := entity("a", 100)
+	neighbors := Neighbors(idx, listener, []*presence.Entity{entity("b", 200)}, 1)
+	if len(neighbors) != 1 || math.Abs(neighbors[0].Distance-100) > 1e-9 {
+		t.Errorf("expected to hear b at a distance of 100, got %v", neighbors)
+	}
+}
+
+// heard is a neighbor as heard by a listener.
+type heard struct {
+	id      string
+	muffled bool
+}
+
+func equalHeard(a, b []heard) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+package zones
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/store"
+	"go.stevenxie.me/zoomcraft/backend/types"
+	"go.stevenxie.me/zoomcraft/backend/util/logutil"
+)
+
+type service struct {
+	repo   store.Collection
+	logger log.Logger
+
+	mu    sync.Mutex
+	zones []*Zone
+	index *Index // rebuilt whenever zones change
+}
+
+// NewService creates a Service that keeps zones in memory, and persists them
+// to repo.
+//
+// Zones are loaded from repo when the Service is created, so repo must not be
+// shared with other Services.
+func NewService(
+	ctx context.Context,
+	repo store.Collection,
+	logger log.Logger,
+) (Service, error) {
+	svc := &service{
+		repo:   repo,
+		logger: level.NewInjector(logger, level.DebugValue()),
+	}
+	if err := repo.List(ctx, &svc.zones); err != nil {
+		return nil, errors.Wrap(err, "zones: load zones")
+	}
+	svc.reindex()
+	return svc, nil
+}
+
+func (svc *service) List(context.Context) ([]*Zone, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	zones := make([]*Zone, len(svc.zones))
+	for i, z := range svc.zones {
+		zones[i] = z.clone()
+	}
+	return zones, nil
+}
+
+func (svc *service) Get(_ context.Context, id types.ID) (*Zone, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	return svc.zones[i].clone(), nil
+}
+
+func (svc *service) Create(ctx context.Context, z *Zone) (_ *Zone, err error) {
+	logger := log.With(svc.logger, "name", z.Name)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Create", err)
+	}(time.Now())
+
+	zone := z.clone()
+	if err = zone.Validate(); err != nil {
+		return nil, err
+	}
+	zone.ID = types.NewID()
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if err := svc.save(ctx, zone); err != nil {
+		return nil, err
+	}
+	svc.zones = append(svc.zones, zone)
+	svc.reindex()
+	return zone.clone(), nil
+}
+
+func (svc *service) Update(
+	ctx context.Context,
+	id types.ID,
+	z *Zone,
+) (_ *Zone, err error) {
+	logger := log.With(svc.logger, "id", id)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Update", err)
+	}(time.Now())
+
+	zone := z.clone()
+	if err = zone.Validate(); err != nil {
+		return nil, err
+	}
+	zone.ID = id
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.save(ctx, zone); err != nil {
+		return nil, err
+	}
+	svc.zones[i] = zone
+	svc.reindex()
+	return zone.clone(), nil
+}
+
+func (svc *service) Delete(ctx context.Context, id types.ID) (err error) {
+	logger := log.With(svc.logger, "id", id)
+	defer func(start time.Time) {
+		l := log.With(logger, "took", time.Since(start))
+		logutil.Trace(l, "Delete", err)
+	}(time.Now())
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	i, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	if err := svc.repo.Delete(ctx, id.Hex()); err != nil {
+		return errors.Wrap(err, "zones: delete zone")
+	}
+	svc.zones = append(svc.zones[:i], svc.zones[i+1:]...)
+	svc.reindex()
+	return nil
+}
+
+func (svc *service) Index(context.Context) (*Index, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	return svc.index, nil
+}
+
+// save persists z to svc.repo.
+func (svc *service) save(ctx context.Context, z *Zone) error {
+	return errors.Wrap(svc.repo.Put(ctx, z.ID.Hex(), z), "zones: save zone")
+}
+
+// reindex rebuilds svc.index from svc.zones.
+//
+// Indexes are immutable, so the zones that they hold must not be modified
+// afterwards; svc.zones are always replaced instead.
+//
+// svc.mu must be held by the caller.
+func (svc *service) reindex() {
+	svc.index = NewIndex(svc.zones)
+}
+
+// find returns the index of the zone with the given ID.
+//
+// svc.mu must be held by the caller.
+func (svc *service) find(id types.ID) (int, error) {
+	for i, z := range svc.zones {
+		if z.ID == id {
+			return i, nil
+		}
+	}
+	err := errors.WithStack(ErrNotFound)
+	return 0, exthttp.WrapWithHTTPCode(err, http.StatusNotFound)
+}
@@ -0,0 +1,291 @@
+// Package zones defines audio zones: regions of the world whose walls limit
+// how sound travels, such as the rooms of an office build.
+package zones
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/exthttp"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+	"go.stevenxie.me/zoomcraft/backend/types"
+)
+
+// A Zone is a region of a space, whose Attenuation determines how sound
+// crosses its boundary.
+//
+// Its shape is either a Box or a Polygon; exactly one of them is set.
+type Zone struct {
+	ID          types.ID    `json:"id" bson:"id"`
+	Name        string      `json:"name" bson:"name"`
+	Space       string      `json:"space" bson:"space"`
+	Attenuation Attenuation `json:"attenuation" bson:"attenuation"`
+
+	Box     *Box     `json:"box,omitempty" bson:"box,omitempty"`
+	Polygon *Polygon `json:"polygon,omitempty" bson:"polygon,omitempty"`
+}
+
+// Contains reports whether p is within z.
+func (z *Zone) Contains(p presence.Position) bool {
+	if z.Box != nil {
+		return z.Box.Contains(p)
+	}
+	return z.Polygon.Contains(p)
+}
+
+// bounds returns the bounding box of z.
+func (z *Zone) bounds() Box {
+	if z.Box != nil {
+		return *z.Box
+	}
+	return z.Polygon.bounds()
+}
+
+// volume returns the volume of z, which is used to prefer inner zones over
+// the zones that enclose them.
+func (z *Zone) volume() float64 {
+	if z.Box != nil {
+		d := z.Box.Max.Sub(z.Box.Min)
+		return d.X * d.Y * d.Z
+	}
+	return z.Polygon.area() * (z.Polygon.MaxY - z.Polygon.MinY)
+}
+
+// Validate checks that z is well-formed, and normalizes its shape.
+func (z *Zone) Validate() error {
+	var err error
+	z.Name = strings.TrimSpace(z.Name)
+	switch n := utf8.RuneCountInString(z.Name); {
+	case n == 0:
+		err = errors.New("zones: empty name")
+	case n > MaxNameLength:
+		err = errors.Newf(
+			"zones: name is longer than %d characters",
+			MaxNameLength,
+		)
+	case z.Space == "":
+		err = errors.New("zones: empty space")
+	case (z.Box == nil) == (z.Polygon == nil):
+		err = errors.New("zones: exactly one of box and polygon must be set")
+	case z.Polygon != nil && len(z.Polygon.Vertices) < 3:
+		err = errors.New("zones: polygon must have at least 3 vertices")
+	default:
+		err = z.Attenuation.Validate()
+	}
+	if err != nil {
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+
+	if z.Box != nil {
+		z.Box.normalize()
+	} else if z.Polygon.MinY > z.Polygon.MaxY {
+		z.Polygon.MinY, z.Polygon.MaxY = z.Polygon.MaxY, z.Polygon.MinY
+	}
+	return nil
+}
+
+func (z *Zone) clone() *Zone {
+	clone := *z
+	if z.Box != nil {
+		box := *z.Box
+		clone.Box = &box
+	}
+	if z.Polygon != nil {
+		poly := *z.Polygon
+		poly.Vertices = append([]Vertex(nil), z.Polygon.Vertices...)
+		clone.Polygon = &poly
+	}
+	return &clone
+}
+
+// A Box is an axis-aligned box, spanning from Min to Max.
+type Box struct {
+	Min presence.Position `json:"min" bson:"min"`
+	Max presence.Position `json:"max" bson:"max"`
+}
+
+// Contains reports whether p is within b.
+func (b *Box) Contains(p presence.Position) bool {
+	return p.X >= b.Min.X && p.X <= b.Max.X &&
+		p.Y >= b.Min.Y && p.Y <= b.Max.Y &&
+		p.Z >= b.Min.Z && p.Z <= b.Max.Z
+}
+
+// normalize ensures that each component of b.Min is no greater than the
+// corresponding component of b.Max.
+func (b *Box) normalize() {
+	b.Min, b.Max = presence.Position{
+		X: math.Min(b.Min.X, b.Max.X),
+		Y: math.Min(b.Min.Y, b.Max.Y),
+		Z: math.Min(b.Min.Z, b.Max.Z),
+	}, presence.Position{
+		X: math.Max(b.Min.X, b.Max.X),
+		Y: math.Max(b.Min.Y, b.Max.Y),
+		Z: math.Max(b.Min.Z, b.Max.Z),
+	}
+}
+
+// A Polygon is a vertical prism, whose horizontal cross-section is the polygon
+// with the given Vertices, and which spans from MinY to MaxY.
+type Polygon struct {
+	Vertices []Vertex `json:"vertices" bson:"vertices"`
+	MinY     float64  `json:"minY" bson:"minY"`
+	MaxY     float64  `json:"maxY" bson:"maxY"`
+}
+
+// A Vertex is a point on the horizontal plane.
+type Vertex struct {
+	X float64 `json:"x" bson:"x"`
+	Z float64 `json:"z" bson:"z"`
+}
+
+// Contains reports whether p is within poly. Like a Box, poly includes its
+// boundary, so points on its edges and vertices are within it.
+func (poly *Polygon) Contains(p presence.Position) bool {
+	if p.Y < poly.MinY || p.Y > poly.MaxY {
+		return false
+	}
+
+	// Cast a ray from p in the +X direction, and count the number of edges
+	// that it crosses.
+	var (
+		vs     = poly.Vertices
+		inside bool
+	)
+	for i, j := 0, len(vs)-1; i < len(vs); j, i = i, i+1 {
+		a, b := vs[i], vs[j]
+		if onSegment(p, a, b) {
+			return true
+		}
+		if (a.Z > p.Z) != (b.Z > p.Z) &&
+			p.X < (b.X-a.X)*(p.Z-a.Z)/(b.Z-a.Z)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// onSegment reports whether p lies on the segment between a and b, on the
+// horizontal plane.
+func onSegment(p presence.Position, a, b Vertex) bool {
+	cross := (b.X-a.X)*(p.Z-a.Z) - (b.Z-a.Z)*(p.X-a.X)
+	return cross == 0 &&
+		p.X >= math.Min(a.X, b.X) && p.X <= math.Max(a.X, b.X) &&
+		p.Z >= math.Min(a.Z, b.Z) && p.Z <= math.Max(a.Z, b.Z)
+}
+
+func (poly *Polygon) bounds() Box {
+	b := Box{
+		Min: presence.Position{X: math.Inf(1), Y: poly.MinY, Z: math.Inf(1)},
+		Max: presence.Position{X: math.Inf(-1), Y: poly.MaxY, Z: math.Inf(-1)},
+	}
+	for _, v := range poly.Vertices {
+		b.Min.X, b.Max.X = math.Min(b.Min.X, v.X), math.Max(b.Max.X, v.X)
+		b.Min.Z, b.Max.Z = math.Min(b.Min.Z, v.Z), math.Max(b.Max.Z, v.Z)
+	}
+	return b
+}
+
+// area returns the area of the cross-section of poly, using the shoelace
+// formula.
+func (poly *Polygon) area() float64 {
+	var (
+		vs  = poly.Vertices
+		sum float64
+	)
+	for i, j := 0, len(vs)-1; i < len(vs); j, i = i, i+1 {
+		sum += vs[j].X*vs[i].Z - vs[i].X*vs[j].Z
+	}
+	return math.Abs(sum) / 2
+}
+
+// An Attenuation determines how sound crosses the boundary of a Zone.
+type Attenuation string
+
+// The set of valid Attenuations.
+const (
+	// AttenuationIsolated zones block all sound from crossing their
+	// boundary.
+	AttenuationIsolated Attenuation = "ISOLATED"
+
+	// AttenuationMuffled zones let sound cross their boundary, muffled.
+	AttenuationMuffled Attenuation = "MUFFLED"
+
+	// AttenuationBroadcast zones block sound from crossing their boundary, and
+	// broadcast sound within them to all of their occupants, regardless of
+	// distance.
+	AttenuationBroadcast Attenuation = "BROADCAST"
+)
+
+// Validate returns an error if a is not a valid Attenuation.
+func (a Attenuation) Validate() error {
+	switch a {
+	case AttenuationIsolated, AttenuationMuffled, AttenuationBroadcast:
+		return nil
+	default:
+		err := errors.Newf("zones: invalid attenuation '%s'", string(a))
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+}
+
+func (a Attenuation) String() string { return string(a) }
+
+var (
+	_ graphql.Marshaler   = (*Attenuation)(nil)
+	_ graphql.Unmarshaler = (*Attenuation)(nil)
+)
+
+// MarshalGQL implements graphql.Marshaler.
+func (a Attenuation) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(a)))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler.
+func (a *Attenuation) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		err := errors.Newf("zones: unsupported field type %T", v)
+		return exthttp.WrapWithHTTPCode(err, http.StatusBadRequest)
+	}
+	if err := Attenuation(s).Validate(); err != nil {
+		return err
+	}
+	*a = Attenuation(s)
+	return nil
+}
+
+// A Service manages Zones.
+type Service interface {
+	// List returns all zones, in the order that they were created.
+	List(ctx context.Context) ([]*Zone, error)
+
+	// Get returns the zone with the given ID.
+	Get(ctx context.Context, id types.ID) (*Zone, error)
+
+	// Create creates a zone from z, and assigns it an ID.
+	Create(ctx context.Context, z *Zone) (*Zone, error)
+
+	// Update replaces the zone with the given ID with z.
+	Update(ctx context.Context, id types.ID, z *Zone) (*Zone, error)
+
+	// Delete deletes the zone with the given ID.
+	Delete(ctx context.Context, id types.ID) error
+
+	// Index returns an Index of all zones.
+	Index(ctx context.Context) (*Index, error)
+}
+
+// MaxNameLength is the maximum length of the name of a Zone.
+const MaxNameLength = 64
+
+// ErrNotFound is returned when a zone could not be found.
+var ErrNotFound = stderrors.New("zones: not found")
@@ -0,0 +1,208 @@
+package zones
+
+import (
+	"testing"
+
+	"go.stevenxie.me/zoomcraft/backend/presence"
+)
+
+func pos(x, y, z float64) presence.Position {
+	return presence.Position{X: x, Y: y, Z: z}
+}
+
+func TestBoxContains(t *testing.T) {
+	box := Box{Min: pos(0, 0, 0), Max: pos(10, 5, 10)}
+	tests := []struct {
+		name string
+		p    presence.Position
+		want bool
+	}{
+		{name: "inside", p: pos(5, 2, 5), want: true},
+		{name: "face", p: pos(0, 2, 5), want: true},
+		{name: "corner", p: pos(10, 5, 10), want: true},
+		{name: "outside", p: pos(10.1, 2, 5)},
+		{name: "below", p: pos(5, -0.1, 5)},
+		{name: "above", p: pos(5, 5.1, 5)},
+	}
+	for _, test := range tests {
+		if got := box.Contains(test.p); got != test.want {
+			t.Errorf("%s: expected Contains(%v) = %t, got %t",
+				test.name, test.p, test.want, got)
+		}
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	var (
+		square = &Polygon{
+			Vertices: []Vertex{{0, 0}, {10, 0}, {10, 10}, {0, 10}},
+			MinY:     0,
+			MaxY:     5,
+		}
+		triangle = &Polygon{
+			Vertices: []Vertex{{0, 0}, {10, 0}, {0, 10}},
+			MinY:     0,
+			MaxY:     5,
+		}
+
+		// A U shape, open towards +Z, with a notch from X = 3 to X = 7.
+		u = &Polygon{
+			Vertices: []Vertex{
+				{0, 0}, {10, 0}, {10, 10}, {7, 10},
+				{7, 3}, {3, 3}, {3, 10}, {0, 10},
+			},
+			MinY: 0,
+			MaxY: 5,
+		}
+
+		// An L shape, missing its top-right quadrant; listed clockwise.
+		l = &Polygon{
+			Vertices: []Vertex{
+				{0, 0}, {0, 10}, {5, 10}, {5, 5}, {10, 5}, {10, 0},
+			},
+			MinY: 0,
+			MaxY: 5,
+		}
+	)
+	tests := []struct {
+		name string
+		poly *Polygon
+		p    presence.Position
+		want bool
+	}{
+		{name: "square inside", poly: square, p: pos(5, 2, 5), want: true},
+		{name: "square outside", poly: square, p: pos(11, 2, 5)},
+		{name: "square left edge", poly: square, p: pos(0, 2, 5), want: true},
+		{name: "square right edge", poly: square, p: pos(10, 2, 5), want: true},
+		{name: "square bottom edge", poly: square, p: pos(5, 2, 0), want: true},
+		{name: "square top edge", poly: square, p: pos(5, 2, 10), want: true},
+		{name: "square vertex", poly: square, p: pos(10, 2, 10), want: true},
+		{name: "square origin", poly: square, p: pos(0, 2, 0), want: true},
+		{name: "square edge extended", poly: square, p: pos(-1, 2, 0)},
+		{name: "square floor", poly: square, p: pos(5, 0, 5), want: true},
+		{name: "square ceiling", poly: square, p: pos(5, 5, 5), want: true},
+		{name: "square below", poly: square, p: pos(5, -1, 5)},
+		{name: "square above", poly: square, p: pos(5, 6, 5)},
+
+		{name: "triangle inside", poly: triangle, p: pos(2, 2, 2), want: true},
+		{name: "triangle hypotenuse", poly: triangle, p: pos(5, 2, 5), want: true},
+		{name: "triangle beyond hypotenuse", poly: triangle, p: pos(5.1, 2, 5)},
+		{name: "triangle apex", poly: triangle, p: pos(0, 2, 10), want: true},
+
+		{name: "u left arm", poly: u, p: pos(1, 2, 8), want: true},
+		{name: "u right arm", poly: u, p: pos(9, 2, 8), want: true},
+		{name: "u base", poly: u, p: pos(5, 2, 1), want: true},
+		{name: "u notch", poly: u, p: pos(5, 2, 8)},
+		{name: "u notch floor", poly: u, p: pos(5, 2, 3), want: true},
+		{name: "u notch wall", poly: u, p: pos(3, 2, 8), want: true},
+		{name: "u notch corner", poly: u, p: pos(7, 2, 3), want: true},
+		{name: "u notch mouth", poly: u, p: pos(5, 2, 10)},
+
+		// Rays cast from these points pass through the reflex vertex (5, 5).
+		{name: "l inside, level with reflex vertex", poly: l, p: pos(2, 2, 5), want: true},
+		{name: "l missing quadrant", poly: l, p: pos(7, 2, 7)},
+		{name: "l reflex vertex", poly: l, p: pos(5, 2, 5), want: true},
+		{name: "l inner edge", poly: l, p: pos(7, 2, 5), want: true},
+		{name: "l outside, level with reflex vertex", poly: l, p: pos(-1, 2, 5)},
+	}
+	for _, test := range tests {
+		if got := test.poly.Contains(test.p); got != test.want {
+			t.Errorf("%s: expected Contains(%v) = %t, got %t",
+				test.name, test.p, test.want, got)
+		}
+	}
+}
+
+func TestZoneVolume(t *testing.T) {
+	tests := []struct {
+		name string
+		zone Zone
+		want float64
+	}{
+		{
+			name: "box",
+			zone: Zone{Box: &Box{Min: pos(0, 0, 0), Max: pos(2, 3, 4)}},
+			want: 24,
+		},
+		{
+			name: "clockwise triangle",
+			zone: Zone{Polygon: &Polygon{
+				Vertices: []Vertex{{0, 0}, {0, 4}, {4, 0}},
+				MaxY:     2,
+			}},
+			want: 16,
+		},
+		{
+			name: "concave polygon",
+			zone: Zone{Polygon: &Polygon{
+				Vertices: []Vertex{
+					{0, 0}, {0, 10}, {5, 10}, {5, 5}, {10, 5}, {10, 0},
+				},
+				MaxY: 1,
+			}},
+			want: 75,
+		},
+	}
+	for _, test := range tests {
+		if got := test.zone.volume(); got != test.want {
+			t.Errorf("%s: expected volume %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestZoneValidate(t *testing.T) {
+	valid := func() *Zone {
+		return &Zone{
+			Name:        " Office ",
+			Space:       "overworld",
+			Attenuation: AttenuationMuffled,
+			Box:         &Box{Min: pos(10, 5, 0), Max: pos(0, 0, 10)},
+		}
+	}
+
+	z := valid()
+	if err := z.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if z.Name != "Office" {
+		t.Errorf("expected name to be trimmed, got %q", z.Name)
+	}
+	if want := (Box{Min: pos(0, 0, 0), Max: pos(10, 5, 10)}); *z.Box != want {
+		t.Errorf("expected box to be normalized to %v, got %v", want, *z.Box)
+	}
+
+	z = valid()
+	z.Box = nil
+	z.Polygon = &Polygon{
+		Vertices: []Vertex{{0, 0}, {1, 0}, {0, 1}},
+		MinY:     5,
+		MaxY:     0,
+	}
+	if err := z.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if z.Polygon.MinY != 0 || z.Polygon.MaxY != 5 {
+		t.Errorf("expected polygon heights to be swapped, got %v to %v",
+			z.Polygon.MinY, z.Polygon.MaxY)
+	}
+
+	for name, modify := range map[string]func(z *Zone){
+		"empty name":  func(z *Zone) { z.Name = " " },
+		"empty space": func(z *Zone) { z.Space = "" },
+		"no shape":    func(z *Zone) { z.Box = nil },
+		"both shapes": func(z *Zone) {
+			z.Polygon = &Polygon{Vertices: []Vertex{{0, 0}, {1, 0}, {0, 1}}}
+		},
+		"degenerate polygon": func(z *Zone) {
+			z.Box = nil
+			z.Polygon = &Polygon{Vertices: []Vertex{{0, 0}, {1, 0}}}
+		},
+		"bad attenuation": func(z *Zone) { z.Attenuation = "LOUD" },
+	} {
+		z := valid()
+		modify(z)
+		if err := z.Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
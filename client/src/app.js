@@ -1,8 +1,6 @@
 import React, { useState, useEffect } from "react";
 import styled from "@emotion/styled";
 
-import io from "socket.io-client";
-
 import {
   ApolloClient,
   ApolloProvider,
@@ -12,6 +10,8 @@ import {
 
 import Intro from "./intro";
 import Dashboard from "./dashboard";
+import connect from "./signaling";
+import { authenticatedFetch } from "./session";
 
 const Container = styled.div`
   min-height: 100vh;
@@ -23,7 +23,7 @@ const Container = styled.div`
 const App = () => {
   const client = new ApolloClient({
     cache: new InMemoryCache(),
-    link: new HttpLink({ uri: "./api/graphql" }),
+    link: new HttpLink({ uri: "./api/graphql", fetch: authenticatedFetch }),
   });
 
   const [socket, setSocket] = useState(null);
@@ -32,7 +32,7 @@ const App = () => {
   // Initialize socket API, handle builtin events.
   useEffect(
     () => {
-      const socket = io({ path: "/api/socket" });
+      const socket = connect("./api/signaling");
       socket.on("error", (error) => {
         console.error("[socket]", error);
       });
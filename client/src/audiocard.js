@@ -115,18 +115,60 @@ const SoundSwitch = ({ source, disabled, ...otherProps }) => {
   );
 };
 
+// PUSH_TO_TALK_KEY is the key that is held to talk, when push-to-talk is on.
+const PUSH_TO_TALK_KEY = "KeyV";
+
+/**
+ * Tracks whether the push-to-talk key is held. If push-to-talk is disabled,
+ * the player is always talking.
+ *
+ * @param {boolean} enabled
+ */
+const usePushToTalk = (enabled) => {
+  const [held, setHeld] = useState(false);
+  useEffect(() => {
+    if (!enabled) return;
+    const key = window.ZOOMCRAFT_PUSH_TO_TALK_KEY ?? PUSH_TO_TALK_KEY;
+    const isTyping = ({ target }) =>
+      target instanceof HTMLInputElement ||
+      target instanceof HTMLTextAreaElement;
+
+    const onKeyDown = (event) => {
+      if (event.code === key && !isTyping(event)) setHeld(true);
+    };
+    const onKeyUp = (event) => {
+      if (event.code === key) setHeld(false);
+    };
+    const onBlur = () => setHeld(false);
+    window.addEventListener("keydown", onKeyDown);
+    window.addEventListener("keyup", onKeyUp);
+    window.addEventListener("blur", onBlur);
+    return () => {
+      window.removeEventListener("keydown", onKeyDown);
+      window.removeEventListener("keyup", onKeyUp);
+      window.removeEventListener("blur", onBlur);
+      setHeld(false);
+    };
+  }, [enabled]);
+  return !enabled || held;
+};
+
 const AudioCard = ({
   source,
   stream,
   username,
   position,
   relation,
+  audible = true,
+  muffled = false,
   orientation,
+  settings,
   onRemove,
 }) => {
   const audio = useRef(null);
   const [output, setOutput] = useState(stream);
   const [panner, setPanner] = useState(null);
+  const [filter, setFilter] = useState(null);
 
   useEffect(() => {
     if (!stream) return;
@@ -148,15 +190,17 @@ const AudioCard = ({
     const acx = new AudioContext();
 
     const panner = acx.createPanner();
-    panner.distanceModel = "linear";
     panner.panningModel = "HRTF";
-    const maxDistanceBlocks = window.ZOOMCRAFT_MAX_DISTANCE ?? 25;
-    panner.maxDistance = maxDistanceBlocks * 100;
     setPanner(panner);
 
+    // Sound that is muffled by a zone loses its high frequencies.
+    const filter = acx.createBiquadFilter();
+    filter.type = "lowpass";
+    setFilter(filter);
+
     const dst = acx.createMediaStreamDestination();
     const src = acx.createMediaStreamSource(stream);
-    src.connect(panner).connect(dst);
+    src.connect(panner).connect(filter).connect(dst);
     setOutput(dst);
     audio.current.srcObject = dst.stream;
 
@@ -164,22 +208,48 @@ const AudioCard = ({
     return () => {
       src.disconnect();
       panner.disconnect();
+      filter.disconnect();
       setPanner(null);
+      setFilter(null);
     };
   }, [stream, source]);
 
-  // Panner updates.
+  // Apply player settings to panner.
+  const { hearingDistance, rolloff } = settings ?? {};
+  useEffect(() => {
+    if (!panner) return;
+    panner.distanceModel = (rolloff ?? "LINEAR").toLowerCase();
+    const maxDistanceBlocks =
+      window.ZOOMCRAFT_MAX_DISTANCE ?? hearingDistance ?? 25;
+    panner.maxDistance = maxDistanceBlocks * 100;
+  }, [panner, hearingDistance, rolloff]);
+
+  // Panner updates. relation is in the listener's frame of reference, where
+  // +Z points forwards, but the listener of an AudioContext faces -Z.
   useEffect(() => {
     if (!(panner && relation)) return;
     const [x, y, z] = relation.map((x) => parseInt(x * 100));
-    panner.setPosition(x, y, z);
+    panner.setPosition(x, y, -z);
   }, [panner, relation]);
 
+  useEffect(() => {
+    if (!filter) return;
+    filter.frequency.value = muffled ? 800 : filter.frequency.maxValue;
+  }, [filter, muffled]);
+
+  // Players that the backend does not report as neighbors (i.e. that are out
+  // of range, or muted in settings) are never heard.
+  const { pushToTalk } = settings ?? {};
+  const isMuted = source === SourceType.INCOMING && !audible;
+
+  // With push-to-talk, the microphone is only live while the key is held.
+  const talking = usePushToTalk(source === SourceType.OUTGOING && pushToTalk);
+
   const [track] = stream?.getAudioTracks() ?? [];
   const [disabled, setDisabled] = useState(false);
   useEffect(() => {
-    if (track) track.enabled = !disabled;
-  }, [track, disabled]);
+    if (track) track.enabled = !disabled && !isMuted && talking;
+  }, [track, disabled, isMuted, talking]);
 
   const formatInfo = (info, unit = "") => {
     if (!info) return "unknown";
@@ -203,7 +273,7 @@ const AudioCard = ({
             <Expanded />
             <SoundSwitch
               source={source}
-              disabled={disabled}
+              disabled={disabled || isMuted || !talking}
               onClick={() => setDisabled(!disabled)}
             />
           </Row>
@@ -1,4 +1,4 @@
-import React, { Component, useState, useEffect } from "react";
+import React, { Component, useState, useEffect, useRef } from "react";
 import styled from "@emotion/styled";
 import { gql, useQuery } from "@apollo/client";
 
@@ -12,7 +12,7 @@ import AudioCard, { SourceType } from "./audiocard";
 import { AddCard } from "./card";
 
 import droplet from "./assets/droplet.wav";
-import { rotate, deg2rad } from "./math";
+import { usePlayers } from "./players";
 
 const Container = styled.div`
   flex: 1;
@@ -32,14 +32,36 @@ const Cards = styled.div`
   flex-wrap: wrap;
 `;
 
+// Positions and orientations are followed through the playerUpdates
+// subscription (see players.js). Neighbors are refetched whenever players
+// move, and settings only change when the player changes them, so they are
+// polled far less often.
+//
+// The backend decides who the player can hear (see Player.neighbors), and
+// where they are relative to the player; the virtual player is placed with
+// relativePosition, since it is not a player.
 const QUERY = gql`
-  query($username: String!) {
-    players {
-      username
-      position
-    }
+  query(
+    $username: String!
+    $maxDistance: Float
+    $virtualPosition: Position!
+    $hasVirtual: Boolean!
+  ) {
     player(username: $username) {
-      orientation
+      settings {
+        hearingDistance
+        rolloff
+        pushToTalk
+      }
+      neighbors(maxDistance: $maxDistance) {
+        player {
+          username
+        }
+        distance
+        direction
+        muffled
+      }
+      relativePosition(position: $virtualPosition) @include(if: $hasVirtual)
     }
   }
 `;
@@ -68,24 +90,46 @@ const Dashboard = ({ username, streams }) => {
     };
   }, [virtualPosition]);
 
-  const { data, error } = useQuery(QUERY, {
-    variables: { username: username },
-    pollInterval: window.ZOOMCRAFT_POLL_INTERVAL ?? 100,
+  const { data, error, refetch } = useQuery(QUERY, {
+    variables: {
+      username: username,
+      maxDistance: window.ZOOMCRAFT_MAX_DISTANCE ?? null,
+      virtualPosition: virtualPosition ?? [0, 0, 0],
+      hasVirtual: !!virtualPosition,
+    },
+    pollInterval: window.ZOOMCRAFT_SETTINGS_POLL_INTERVAL ?? 5000,
   });
   if (error) console.error(`[dashboard] failed to load player data`, error);
-  const players = keyBy(data?.players, "username");
+  const settings = data?.player?.settings;
+  const neighbors = keyBy(data?.player?.neighbors, "player.username");
+
+  const { players, error: playersError } = usePlayers();
+  useEffect(() => {
+    if (playersError) {
+      console.error(`[dashboard] failed to follow players`, playersError);
+    }
+  }, [playersError]);
+
+  // Refetch neighbors whenever players move.
+  const refetchRef = useRef(refetch);
+  refetchRef.current = refetch;
+  useEffect(() => {
+    refetchRef.current();
+  }, [players]);
 
   // Preload position and orientation for current player.
-  const position = get(players, username, {}).position;
-  const orientation = data?.player?.orientation;
-
-  // Calculates relative position.
-  const relation = (position1, position2) => {
-    if (!(position1 && position2 && orientation)) return undefined;
-    const [x1, y1, z1] = position1;
-    const [x2, y2, z2] = position2;
-    const [ay, ax] = orientation;
-    return rotate([x1 - x2, y2 - y1, z2 - z1], deg2rad(-ay), deg2rad(-ax));
+  const { position, orientation } = get(players, username, {});
+
+  // The backend only reports neighbors beyond the hearing distance where
+  // distance does not matter (i.e. in global rooms and broadcast zones), so
+  // they are heard at full volume, from their direction alone.
+  const maxDistance =
+    window.ZOOMCRAFT_MAX_DISTANCE ?? settings?.hearingDistance ?? 25;
+  const relation = (neighbor) => {
+    if (!neighbor) return undefined;
+    const { direction, distance } = neighbor;
+    const scale = distance > maxDistance ? 1 : distance;
+    return direction.map((x) => x * scale);
   };
 
   return (
@@ -96,6 +140,7 @@ const Dashboard = ({ username, streams }) => {
           const targetPlayer = get(players, targetUsername, {});
           const { position: targetPosition } = targetPlayer;
           const own = targetUsername === username;
+          const neighbor = neighbors[targetUsername];
           return (
             <AudioCard
               key={targetUsername}
@@ -103,8 +148,11 @@ const Dashboard = ({ username, streams }) => {
               stream={stream}
               username={targetUsername}
               position={targetPosition}
-              relation={own ? undefined : relation(position, targetPosition)}
+              relation={own ? undefined : relation(neighbor)}
+              audible={own || !!neighbor}
+              muffled={neighbor?.muffled}
               orientation={own ? orientation : undefined}
+              settings={settings}
             />
           );
         })}
@@ -114,7 +162,8 @@ const Dashboard = ({ username, streams }) => {
             stream={virtualStream}
             username="VIRTUAL"
             position={virtualPosition}
-            relation={relation(position, virtualPosition)}
+            relation={data?.player?.relativePosition}
+            settings={settings}
             onRemove={() => setVirtualPosition(null)}
           />
         ) : (
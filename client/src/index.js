@@ -34,8 +34,8 @@ ReactDOM.render(
 if (typeof window !== "undefined") {
   window.ZOOMCRAFT_NEGOTIATION_TIMEOUT = 2000;
   window.ZOOMCRAFT_SKIP_VALIDATION = false;
-  window.ZOOMCRAFT_POLL_INTERVAL = 100;
-  window.ZOOMCRAFT_MAX_DISTANCE = 25;
+  window.ZOOMCRAFT_SETTINGS_POLL_INTERVAL = 5000;
+  window.ZOOMCRAFT_MAX_DISTANCE = undefined;
   window.ZOOMCRAFT_ICE_SERVERS = undefined;
 }
 
@@ -3,6 +3,8 @@ import styled from "@emotion/styled";
 
 import { useApolloClient, gql } from "@apollo/client";
 
+import { loadSession, saveSession, clearSession } from "./session";
+
 const Container = styled.div`
   background: black;
   flex: 1;
@@ -76,6 +78,23 @@ const Menu = styled.div`
       }
     }
   }
+
+  .instructions {
+    margin-top: 1.5rem;
+    max-width: 20rem;
+    color: #b8b8b8;
+
+    code {
+      color: white;
+      font-weight: 700;
+    }
+  }
+`;
+
+const AUTH_REQUIRED = gql`
+  query {
+    authRequired
+  }
 `;
 
 const PLAYER_USERNAMES = gql`
@@ -86,6 +105,31 @@ const PLAYER_USERNAMES = gql`
   }
 `;
 
+const LOGIN = gql`
+  mutation Login($username: String!) {
+    login(username: $username) {
+      id
+      instructions
+      expiresAt
+    }
+  }
+`;
+
+const COMPLETE_LOGIN = gql`
+  mutation CompleteLogin($id: String!) {
+    completeLogin(id: $id) {
+      username
+      token
+      expiresAt
+    }
+  }
+`;
+
+// How often to check whether a login has been completed, in milliseconds.
+const LOGIN_POLL_INTERVAL = 2000;
+
+const sleep = (ms) => new Promise((resolve) => setTimeout(resolve, ms));
+
 const Intro = ({ socket, onSubmit }) => {
   const input = useRef(null);
   const client = useApolloClient();
@@ -93,14 +137,61 @@ const Intro = ({ socket, onSubmit }) => {
   const [disabled, setDisabled] = useState(!socket);
   useEffect(() => setDisabled(!socket), [socket]);
 
+  // The instructions of the login in progress, if any.
+  const [instructions, setInstructions] = useState(null);
+
+  // Logs in as the player with the given username, unless a session for it is
+  // already stored.
+  const login = async (username) => {
+    const session = loadSession();
+    if (session && session.username === username) return session;
+    clearSession();
+
+    const { data } = await client.mutate({
+      mutation: LOGIN,
+      variables: { username },
+    });
+    const { id, instructions, expiresAt } = data.login;
+    setInstructions(instructions);
+    try {
+      while (new Date(expiresAt) > new Date()) {
+        await sleep(LOGIN_POLL_INTERVAL);
+        const { data } = await client.mutate({
+          mutation: COMPLETE_LOGIN,
+          variables: { id },
+        });
+        if (data.completeLogin) {
+          saveSession(data.completeLogin);
+          return data.completeLogin;
+        }
+      }
+      throw new Error("Login expired.");
+    } finally {
+      setInstructions(null);
+    }
+  };
+
   const handleSubmit = async (event) => {
     event.preventDefault();
     setDisabled(true);
     const { value: username } = input.current;
     if (!username) return alert("Please enter a username.");
 
-    // Check valid usernames.
-    if (!window.ZOOMCRAFT_SKIP_VALIDATION) {
+    // Log in, if required.
+    const { data } = await client.query({
+      query: AUTH_REQUIRED,
+      fetchPolicy: "network-only",
+    });
+    let token;
+    if (data.authRequired) {
+      try {
+        ({ token } = await login(username));
+      } catch (error) {
+        setDisabled(false);
+        return alert(`Failed to log in: ${error.message}`);
+      }
+    } else if (!window.ZOOMCRAFT_SKIP_VALIDATION) {
+      // Check valid usernames.
       const { data } = await client.query({
         query: PLAYER_USERNAMES,
         fetchPolicy: "network-only",
@@ -113,8 +204,9 @@ const Intro = ({ socket, onSubmit }) => {
     }
 
     // Register with username.
-    socket.emit("register", { username }, ({ error }) => {
+    socket.emit("register", { username, token }, ({ error }) => {
       if (error) {
+        if (error === "not authenticated") clearSession();
         alert(`Failed to connect: ${error.toString()}`);
         setDisabled(false);
       } else if (onSubmit) {
@@ -143,6 +235,11 @@ const Intro = ({ socket, onSubmit }) => {
             type="submit"
           />
         </form>
+        {instructions && (
+          <p className="instructions">
+            To log in, enter <code>{instructions}</code> in Minecraft.
+          </p>
+        )}
       </Menu>
     </Container>
   );
@@ -0,0 +1,105 @@
+// Follows the players in the world through the backend's playerUpdates
+// subscription (served over a websocket), so that every browser shares the
+// backend's single poller instead of polling the backend itself.
+
+import { useState, useEffect } from "react";
+import { SubscriptionClient } from "subscriptions-transport-ws";
+import { print } from "graphql";
+import { gql } from "@apollo/client";
+
+import omit from "lodash/omit";
+import keyBy from "lodash/keyBy";
+
+import { loadSession } from "./session";
+
+const SUBSCRIPTION = gql`
+  subscription {
+    playerUpdates {
+      players {
+        username
+        position
+        orientation
+      }
+      departed
+    }
+  }
+`;
+
+/**
+ * Connects to the GraphQL websocket endpoint at path (relative to the current
+ * page), authenticating with the token of the stored session (if any).
+ */
+function connect(path) {
+  const url = new URL(path, window.location.href);
+  url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+  return new SubscriptionClient(url.toString(), {
+    reconnect: true,
+    connectionParams: () => {
+      const session = loadSession();
+      if (!session) return {};
+      return { Authorization: `Bearer ${session.token}` };
+    },
+  });
+}
+
+/**
+ * Returns the players in the world, by username, and the last error (if any).
+ *
+ * The first update of each subscription holds all players, and later updates
+ * only hold the players that changed or departed since.
+ */
+export function usePlayers(path = "./api/graphql") {
+  const [players, setPlayers] = useState({});
+  const [error, setError] = useState(null);
+
+  useEffect(() => {
+    const client = connect(path);
+    let subscription;
+    let full = true; // whether the next update holds all players
+    let retry;
+    let closed = false;
+
+    // Resubscriptions (after reconnecting, or after the backend ends the
+    // subscription because it fell behind) start with all players again.
+    const stopReconnected = client.onReconnected(() => (full = true));
+    const subscribe = () => {
+      if (closed) return;
+      full = true;
+      const request = client.request({ query: print(SUBSCRIPTION) });
+      subscription = request.subscribe({
+        next: ({ data, errors }) => {
+          if (errors) {
+            setError(errors);
+            return;
+          }
+          const { players: changed, departed } = data.playerUpdates;
+          const initial = full;
+          full = false;
+          setPlayers((players) => ({
+            ...omit(initial ? {} : players, departed),
+            ...keyBy(changed, "username"),
+          }));
+          setError(null);
+        },
+        error: (error) => {
+          setError(error);
+          retry = setTimeout(subscribe, 1000);
+        },
+        complete: () => {
+          retry = setTimeout(subscribe, 1000);
+        },
+      });
+    };
+    subscribe();
+
+    return () => {
+      closed = true;
+      clearTimeout(retry);
+      stopReconnected();
+      subscription.unsubscribe();
+      client.close();
+    };
+  }, [path]);
+
+  return { players, error };
+}
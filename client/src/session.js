@@ -0,0 +1,42 @@
+// Stores the session that authenticates requests to the backend, which is
+// obtained by logging in (see intro.js).
+
+const KEY = "zoomcraft:session";
+
+/**
+ * Returns the stored session, if it has yet to expire.
+ */
+export function loadSession() {
+  try {
+    const session = JSON.parse(localStorage.getItem(KEY));
+    if (session && new Date(session.expiresAt) > new Date()) return session;
+  } catch (error) {
+    console.error("[session] failed to load", error);
+  }
+  return null;
+}
+
+/**
+ * Stores session, replacing any existing one.
+ */
+export function saveSession(session) {
+  localStorage.setItem(KEY, JSON.stringify(session));
+}
+
+/**
+ * Forgets the stored session.
+ */
+export function clearSession() {
+  localStorage.removeItem(KEY);
+}
+
+/**
+ * Fetches resource like window.fetch, but with an Authorization header that
+ * holds the token of the stored session (if any).
+ */
+export function authenticatedFetch(resource, init = {}) {
+  const session = loadSession();
+  if (!session) return fetch(resource, init);
+  const headers = { ...init.headers, Authorization: `Bearer ${session.token}` };
+  return fetch(resource, { ...init, headers });
+}
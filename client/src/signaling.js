@@ -0,0 +1,51 @@
+// A client for the backend's signaling server, which exposes the same
+// event-based interface as a socket.io socket:
+//
+//   - socket.on(event, handler) registers a handler for an event.
+//   - socket.emit(event, data, reply) sends an event; "register" events are
+//     replied to once the server has processed them.
+//   - socket.disconnect() closes the connection.
+//
+// Besides the "register", "data", and "deregister" events sent by the server,
+// the socket emits "connect", "disconnect", and "error" events.
+
+/**
+ * Connects to the signaling server at path (relative to the current page).
+ */
+export default function connect(path) {
+  const url = new URL(path, window.location.href);
+  url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+  const ws = new WebSocket(url.toString());
+
+  const handlers = {};
+  const dispatch = (event, ...args) =>
+    (handlers[event] || []).forEach((handler) => handler(...args));
+
+  // Replies to "register" events, in the order that they were sent.
+  const replies = [];
+
+  ws.onopen = () => dispatch("connect");
+  ws.onclose = () => dispatch("disconnect");
+  ws.onerror = (error) => dispatch("error", error);
+  ws.onmessage = ({ data }) => {
+    const { type, ...message } = JSON.parse(data);
+    if (type === "registered") {
+      const reply = replies.shift();
+      if (reply) reply(message);
+      return;
+    }
+    dispatch(type, message);
+  };
+
+  const socket = {
+    on: (event, handler) => {
+      (handlers[event] = handlers[event] || []).push(handler);
+    },
+    emit: (event, data, reply) => {
+      if (event === "register") replies.push(reply || (() => {}));
+      ws.send(JSON.stringify({ type: event, ...data }));
+    },
+    disconnect: () => ws.close(),
+  };
+  return socket;
+}
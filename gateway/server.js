@@ -9,12 +9,35 @@ const { GATEWAY_PORT, BACKEND_PORT, CLIENT_PORT, CLIENT_PATH } = process.env;
 // Create app.
 const app = express();
 
+// Proxy GraphQL subscriptions (over websockets) to external backend.
+//
+// Requests to the backend carry the addresses of their clients in
+// X-Forwarded-For (see BACKEND_TRUST_PROXY).
+app.use(
+  createProxyMiddleware("/api/graphql", {
+    target: `http://localhost:${BACKEND_PORT}`,
+    pathRewrite: { "^/api": "" },
+    ws: true,
+    xfwd: true,
+  })
+);
+
+// Proxy WebRTC signaling (over websockets) to external backend.
+app.use(
+  createProxyMiddleware("/api/signaling", {
+    target: `http://localhost:${BACKEND_PORT}`,
+    pathRewrite: { "^/api": "" },
+    ws: true,
+  })
+);
+
 // Proxy requests to /api to external backend.
 app.use(
   "/api",
   createProxyMiddleware({
     target: `http://localhost:${BACKEND_PORT}`,
     pathRewrite: { "^/api": "" },
+    xfwd: true,
   })
 );
 
@@ -33,10 +56,6 @@ if (CLIENT_PORT) {
 // Create gateway server.
 const server = require("http").createServer(app);
 
-// Register socket server.
-const socket = require("./socket");
-socket(server);
-
 // Start gateway server.
 const port = GATEWAY_PORT || 8080;
 server.listen(port, () => console.log(`[gateway]`, `listening on :${port}`));